@@ -15,12 +15,15 @@ import (
 )
 
 type configCommand struct {
-	viper *viper.Viper
-	cmd   *cobra.Command
+	viper     *viper.Viper
+	cmd       *cobra.Command
+	verifyCmd *cobra.Command
 
 	opts       config.LoaderOptions
 	verifyOpts verifyOptions
 
+	cfg *config.Config
+
 	buildInfo BuildInfo
 
 	log logutils.Log
@@ -73,20 +76,25 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 	verifyFlagSet := verifyCommand.Flags()
 	verifyFlagSet.StringVar(&c.verifyOpts.schemaURL, "schema", "", color.GreenString("JSON schema URL"))
 	_ = verifyFlagSet.MarkHidden("schema")
+	verifyFlagSet.IntVar(&c.verifyOpts.expiringWithin, "expiring-within", 0,
+		color.GreenString("Report the exclude and severity rules expiring within the given number of days"))
 
 	c.cmd = configCmd
+	c.verifyCmd = verifyCommand
 
 	return c
 }
 
 func (c *configCommand) preRunE(cmd *cobra.Command, args []string) error {
 	// The command doesn't depend on the real configuration.
-	// It only needs to know the path of the configuration file.
-	cfg := config.NewDefault()
+	// It only needs to know the path of the configuration file,
+	// the loaded configuration is only used to report the expiring rules.
+	c.cfg = config.NewDefault()
 
-	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, cfg, args)
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, c.cfg, args)
 
-	err := loader.Load(config.LoadOptions{})
+	// The unknown options are errors for the verification of the configuration.
+	err := loader.Load(config.LoadOptions{Strict: cmd == c.verifyCmd})
 	if err != nil {
 		return fmt.Errorf("can't load config: %w", err)
 	}
@@ -20,10 +20,14 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
 type verifyOptions struct {
 	schemaURL string // For debugging purpose only (Flag only).
+
+	expiringWithin int
 }
 
 func (c *configCommand) executeVerify(cmd *cobra.Command, _ []string) error {
@@ -50,9 +54,32 @@ func (c *configCommand) executeVerify(cmd *cobra.Command, _ []string) error {
 		return errors.New("the configuration contains invalid elements")
 	}
 
+	c.printExpiringRules(cmd)
+
+	// The linters with incompatible settings, or reporting the same issues, are reported by the validation of the linters.
+	_, err = lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), c.cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log))
+	if err != nil {
+		return fmt.Errorf("[%s] validate linters: %w", usedConfigFile, err)
+	}
+
 	return nil
 }
 
+// printExpiringRules prints the exclude and severity rules expired, or expiring within the number of days defined by the flag.
+func (c *configCommand) printExpiringRules(cmd *cobra.Command) {
+	now := time.Now()
+
+	for _, rule := range c.cfg.GetExpiredRules(now.AddDate(0, 0, c.verifyOpts.expiringWithin)) {
+		if rule.Rule.IsExpired(now) {
+			cmd.PrintErrf("expired rule: %s\n", rule)
+			continue
+		}
+
+		cmd.PrintErrf("expiring rule: %s\n", rule)
+	}
+}
+
 func createSchemaURL(flags *pflag.FlagSet, buildInfo BuildInfo) (string, error) {
 	schemaURL, err := flags.GetString("schema")
 	if err != nil {
@@ -163,7 +190,32 @@ func decodeYamlFile(filename string) (any, error) {
 		return nil, fmt.Errorf("[%s] YAML decode: %w", filename, err)
 	}
 
-	return m, nil
+	return timestampsToStrings(m), nil
+}
+
+// timestampsToStrings converts the timestamps (i.e. unquoted dates) decoded by the YAML decoder to strings,
+// the JSON schema only knows strings.
+func timestampsToStrings(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		for k, item := range value {
+			value[k] = timestampsToStrings(item)
+		}
+
+	case []any:
+		for i, item := range value {
+			value[i] = timestampsToStrings(item)
+		}
+
+	case time.Time:
+		if h, m, sec := value.Clock(); h == 0 && m == 0 && sec == 0 {
+			return value.Format(time.DateOnly)
+		}
+
+		return value.Format(time.RFC3339)
+	}
+
+	return v
 }
 
 func decodeTomlFile(filename string) (any, error) {
@@ -83,6 +83,8 @@ func setupOutputFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddFlagAndBind(v, fs, fs.String, "path-prefix", "output.path-prefix", "",
 		color.GreenString("Path prefix to add to output"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "show-stats", "output.show-stats", false, color.GreenString("Show statistics per linter"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "explain-hidden", "output.explain-hidden", false,
+		color.GreenString("Show the hidden issues, with the reason why they are hidden"))
 }
 
 //nolint:gomnd // magic numbers here is ok
@@ -121,6 +123,8 @@ func setupIssuesFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 		color.GreenString("Show issues in any part of update files (requires new-from-rev or new-from-patch)"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "fix", "issues.fix", false,
 		color.GreenString("Fix found issues (if it's supported by the linter)"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "fail-on-unused-rules", "issues.fail-on-unused-rules", false,
+		color.GreenString("Fail if some exclude rules or severity rules don't match any issue"))
 }
 
 func getDefaultIssueExcludeHelp() string {
@@ -0,0 +1,273 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/pkg/commands/internal"
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+const reportFromJSON = "json"
+
+type convertOptions struct {
+	from string
+}
+
+type reportCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts        config.LoaderOptions
+	convertOpts convertOptions
+	diffOpts    diffOptions
+
+	cfg *config.Config
+
+	log logutils.Log
+}
+
+func newReportCommand(log logutils.Log) *reportCommand {
+	c := &reportCommand{
+		viper: viper.New(),
+		log:   log,
+	}
+
+	reportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "Transform the results of previous runs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// ex: golangci-lint report convert --from json a.json b.json --out-format sarif:out.sarif,checkstyle:out.xml
+	convertCmd := &cobra.Command{
+		Use:          "convert [flags] files...",
+		Short:        "Merge the results of several runs and print them with other output formats",
+		Args:         cobra.MinimumNArgs(1),
+		PreRunE:      c.preRunE,
+		RunE:         c.executeConvert,
+		SilenceUsage: true,
+	}
+
+	// ex: golangci-lint report diff base.json head.json --format markdown
+	diffCmd := &cobra.Command{
+		Use:          "diff [flags] base head",
+		Short:        "Count the issues introduced and fixed between 2 results",
+		Args:         cobra.ExactArgs(2),
+		RunE:         c.executeDiff,
+		SilenceUsage: true,
+	}
+
+	reportCmd.AddCommand(convertCmd, diffCmd)
+
+	convertFlagSet := convertCmd.Flags()
+	convertFlagSet.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(convertFlagSet, &c.opts)
+
+	convertFlagSet.StringVar(&c.convertOpts.from, "from", reportFromJSON,
+		color.GreenString(fmt.Sprintf("Format of the results: %s", reportFromJSON)))
+	internal.AddFlagAndBind(c.viper, convertFlagSet, convertFlagSet.String, "out-format", "output.formats", config.OutFormatColoredLineNumber,
+		color.GreenString(fmt.Sprintf("Formats of output: %s", strings.Join(config.AllOutputFormats, "|"))))
+	internal.AddFlagAndBind(c.viper, convertFlagSet, convertFlagSet.Bool, "print-issued-lines", "output.print-issued-lines", true,
+		color.GreenString("Print lines of code with issue"))
+	internal.AddFlagAndBind(c.viper, convertFlagSet, convertFlagSet.StringSlice, "sort-order", "output.sort-order", nil,
+		color.GreenString("Sort order of linter results"))
+
+	diffFlagSet := diffCmd.Flags()
+	diffFlagSet.StringVar(&c.diffOpts.format, "format", diffFormatText,
+		color.GreenString(fmt.Sprintf("Format of the output: %s", strings.Join(diffFormats, "|"))))
+
+	c.cmd = reportCmd
+
+	return c
+}
+
+func (c *reportCommand) preRunE(cmd *cobra.Command, _ []string) error {
+	c.cfg = config.NewDefault()
+
+	// The arguments are the results, not the analyzed packages:
+	// the configuration file is searched from the working directory.
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, c.cfg, nil)
+
+	// Only the output section is used: the other sections can be invalid, e.g. the configuration of another version.
+	err := loader.LoadOutput()
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	// The results are always sorted: the order of the issues depends on the order of the merged files.
+	c.cfg.Output.SortResults = true
+
+	return c.cfg.Output.Validate()
+}
+
+func (c *reportCommand) executeConvert(_ *cobra.Command, args []string) error {
+	if c.convertOpts.from != reportFromJSON {
+		return fmt.Errorf("unsupported format of the results %q: only %q is supported", c.convertOpts.from, reportFromJSON)
+	}
+
+	// The JSON results don't record the new issues.
+	if c.cfg.Output.Formats.HasOnlyNew() {
+		return errors.New("only-new can't be used to convert the results: the new issues are not recorded")
+	}
+
+	results, err := readJSONResults(args)
+	if err != nil {
+		return err
+	}
+
+	issues, reportData := mergeResults(results)
+
+	issues, err = processors.NewSortResults(c.cfg).Process(issues)
+	if err != nil {
+		return fmt.Errorf("can't sort the issues: %w", err)
+	}
+
+	printer, err := printers.NewPrinter(c.log, &c.cfg.Output, reportData)
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(issues)
+}
+
+func readJSONResults(paths []string) ([]printers.JSONResult, error) {
+	results := make([]printers.JSONResult, 0, len(paths))
+
+	for _, path := range paths {
+		res, err := readJSONResult(path)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func readJSONResult(path string) (printers.JSONResult, error) {
+	var res printers.JSONResult
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return res, fmt.Errorf("can't read the results: %w", err)
+	}
+
+	err = json.Unmarshal(content, &res)
+	if err != nil {
+		return res, fmt.Errorf("can't decode the results %s: %w", path, err)
+	}
+
+	if res.Report == nil {
+		res.Report = &report.Data{}
+	}
+
+	return res, nil
+}
+
+// mergeKey identifies an issue reported by several results, e.g. a package analyzed by several shards.
+type mergeKey struct {
+	linter      string
+	fingerprint string
+	line        int
+	column      int
+}
+
+func newMergeKey(issue *result.Issue) mergeKey {
+	return mergeKey{
+		linter:      issue.FromLinter,
+		fingerprint: issue.Fingerprint(),
+		line:        issue.Line(),
+		column:      issue.Column(),
+	}
+}
+
+// mergeResults merges the issues and the report data of the results.
+// The duplicated issues, warnings, and linters are kept once.
+func mergeResults(results []printers.JSONResult) ([]result.Issue, *report.Data) {
+	var issues []result.Issue
+
+	reportData := &report.Data{}
+
+	seenIssues := map[mergeKey]bool{}
+	seenHidden := map[mergeKey]bool{}
+	linters := map[string]int{}
+
+	var errs []string
+
+	for _, res := range results {
+		for i := range res.Issues {
+			key := newMergeKey(&res.Issues[i])
+			if seenIssues[key] {
+				continue
+			}
+
+			seenIssues[key] = true
+			issues = append(issues, res.Issues[i])
+		}
+
+		if reportData.Version == "" {
+			reportData.Version = res.Report.Version
+		}
+
+		// The first failure is kept.
+		if reportData.ExitCode == 0 {
+			reportData.ExitCode = res.Report.ExitCode
+		}
+
+		for _, warning := range res.Report.Warnings {
+			if !slices.Contains(reportData.Warnings, warning) {
+				reportData.Warnings = append(reportData.Warnings, warning)
+			}
+		}
+
+		for _, lc := range res.Report.Linters {
+			index, ok := linters[lc.Name]
+			if !ok {
+				linters[lc.Name] = len(reportData.Linters)
+				reportData.Linters = append(reportData.Linters, lc)
+
+				continue
+			}
+
+			// A linter is enabled if it has been enabled for one of the runs.
+			reportData.Linters[index].Enabled = reportData.Linters[index].Enabled || lc.Enabled
+		}
+
+		for i := range res.Report.HiddenIssues {
+			key := newMergeKey(&res.Report.HiddenIssues[i].Issue)
+			if seenHidden[key] {
+				continue
+			}
+
+			seenHidden[key] = true
+			reportData.HiddenIssues = append(reportData.HiddenIssues, res.Report.HiddenIssues[i])
+		}
+
+		if res.Report.Error != "" && !slices.Contains(errs, res.Report.Error) {
+			errs = append(errs, res.Report.Error)
+		}
+	}
+
+	reportData.Error = strings.Join(errs, "; ")
+
+	return issues, reportData
+}
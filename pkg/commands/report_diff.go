@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/printers"
+)
+
+const (
+	diffFormatText     = "text"
+	diffFormatJSON     = "json"
+	diffFormatMarkdown = "markdown"
+)
+
+var diffFormats = []string{diffFormatText, diffFormatJSON, diffFormatMarkdown}
+
+type diffOptions struct {
+	format string
+}
+
+// diffCounts are the numbers of issues introduced, fixed, and unchanged between 2 results.
+type diffCounts struct {
+	Linter     string `json:",omitempty"`
+	Introduced int
+	Fixed      int
+	Unchanged  int
+}
+
+type diffResult struct {
+	Linters []diffCounts
+	Total   diffCounts
+}
+
+func (c *reportCommand) executeDiff(_ *cobra.Command, args []string) error {
+	if !slices.Contains(diffFormats, c.diffOpts.format) {
+		return fmt.Errorf("unsupported format %q: %s", c.diffOpts.format, strings.Join(diffFormats, "|"))
+	}
+
+	base, err := readJSONResult(args[0])
+	if err != nil {
+		return err
+	}
+
+	head, err := readJSONResult(args[1])
+	if err != nil {
+		return err
+	}
+
+	res := diffResults(&base, &head)
+
+	switch c.diffOpts.format {
+	case diffFormatJSON:
+		return json.NewEncoder(logutils.StdOut).Encode(res)
+	case diffFormatMarkdown:
+		return printDiffMarkdown(logutils.StdOut, res)
+	default:
+		return printDiffText(logutils.StdOut, res)
+	}
+}
+
+// diffResults matches the issues of the 2 results by linter and fingerprint.
+// The fingerprint doesn't depend on the line of the issue,
+// so an issue moved by the changes of the code is unchanged.
+func diffResults(base, head *printers.JSONResult) diffResult {
+	type key struct{ linter, fingerprint string }
+
+	baseCounts := map[key]int{}
+	for i := range base.Issues {
+		baseCounts[key{linter: base.Issues[i].FromLinter, fingerprint: base.Issues[i].Fingerprint()}]++
+	}
+
+	headCounts := map[key]int{}
+	for i := range head.Issues {
+		headCounts[key{linter: head.Issues[i].FromLinter, fingerprint: head.Issues[i].Fingerprint()}]++
+	}
+
+	linters := map[string]*diffCounts{}
+
+	getLinter := func(name string) *diffCounts {
+		if _, ok := linters[name]; !ok {
+			linters[name] = &diffCounts{Linter: name}
+		}
+
+		return linters[name]
+	}
+
+	for k, count := range baseCounts {
+		unchanged := min(count, headCounts[k])
+
+		counts := getLinter(k.linter)
+		counts.Unchanged += unchanged
+		counts.Fixed += count - unchanged
+	}
+
+	for k, count := range headCounts {
+		getLinter(k.linter).Introduced += count - min(count, baseCounts[k])
+	}
+
+	res := diffResult{Linters: []diffCounts{}}
+
+	for _, counts := range linters {
+		res.Linters = append(res.Linters, *counts)
+
+		res.Total.Introduced += counts.Introduced
+		res.Total.Fixed += counts.Fixed
+		res.Total.Unchanged += counts.Unchanged
+	}
+
+	slices.SortFunc(res.Linters, func(a, b diffCounts) int {
+		return strings.Compare(a.Linter, b.Linter)
+	})
+
+	return res
+}
+
+func printDiffText(w io.Writer, res diffResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "Linter\tIntroduced\tFixed\tUnchanged")
+
+	for _, counts := range res.Linters {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\n", counts.Linter, counts.Introduced, counts.Fixed, counts.Unchanged)
+	}
+
+	_, _ = fmt.Fprintf(tw, "Total\t%d\t%d\t%d\n", res.Total.Introduced, res.Total.Fixed, res.Total.Unchanged)
+
+	return tw.Flush()
+}
+
+func printDiffMarkdown(w io.Writer, res diffResult) error {
+	buf := new(strings.Builder)
+
+	buf.WriteString("| Linter | Introduced | Fixed | Unchanged |\n")
+	buf.WriteString("|--------|-----------:|------:|----------:|\n")
+
+	for _, counts := range res.Linters {
+		fmt.Fprintf(buf, "| %s | %d | %d | %d |\n", counts.Linter, counts.Introduced, counts.Fixed, counts.Unchanged)
+	}
+
+	fmt.Fprintf(buf, "| **Total** | **%d** | **%d** | **%d** |\n", res.Total.Introduced, res.Total.Fixed, res.Total.Unchanged)
+
+	_, err := io.WriteString(w, buf.String())
+
+	return err
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newDiffIssue(linter, text string, line int) result.Issue {
+	return result.Issue{
+		FromLinter:  linter,
+		Text:        text,
+		SourceLines: []string{"\tf()"},
+		Pos:         token.Position{Filename: "a.go", Line: line},
+	}
+}
+
+func Test_diffResults(t *testing.T) {
+	base := &printers.JSONResult{
+		Issues: []result.Issue{
+			newDiffIssue("errcheck", "unchecked", 10),
+			newDiffIssue("errcheck", "unchecked", 12), // Same fingerprint.
+			newDiffIssue("govet", "fixed", 20),
+		},
+	}
+
+	head := &printers.JSONResult{
+		Issues: []result.Issue{
+			newDiffIssue("errcheck", "unchecked", 15), // Moved.
+			newDiffIssue("errcheck", "unchecked", 17),
+			newDiffIssue("errcheck", "unchecked", 19),
+			newDiffIssue("misspell", "new", 30),
+		},
+	}
+
+	expected := diffResult{
+		Linters: []diffCounts{
+			{Linter: "errcheck", Introduced: 1, Unchanged: 2},
+			{Linter: "govet", Fixed: 1},
+			{Linter: "misspell", Introduced: 1},
+		},
+		Total: diffCounts{Introduced: 2, Fixed: 1, Unchanged: 2},
+	}
+
+	assert.Equal(t, expected, diffResults(base, head))
+}
+
+func Test_printDiffText(t *testing.T) {
+	res := diffResult{
+		Linters: []diffCounts{
+			{Linter: "errcheck", Introduced: 1, Unchanged: 2},
+			{Linter: "govet", Fixed: 10},
+		},
+		Total: diffCounts{Introduced: 1, Fixed: 10, Unchanged: 2},
+	}
+
+	buf := new(bytes.Buffer)
+
+	err := printDiffText(buf, res)
+	require.NoError(t, err)
+
+	expected := `Linter    Introduced  Fixed  Unchanged
+errcheck  1           0      2
+govet     0           10     0
+Total     1           10     2
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func Test_printDiffMarkdown(t *testing.T) {
+	res := diffResult{
+		Linters: []diffCounts{
+			{Linter: "errcheck", Introduced: 1, Unchanged: 2},
+		},
+		Total: diffCounts{Introduced: 1, Unchanged: 2},
+	}
+
+	buf := new(bytes.Buffer)
+
+	err := printDiffMarkdown(buf, res)
+	require.NoError(t, err)
+
+	expected := `| Linter | Introduced | Fixed | Unchanged |
+|--------|-----------:|------:|----------:|
+| errcheck | 1 | 0 | 2 |
+| **Total** | **1** | **0** | **2** |
+`
+
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func Test_mergeResults(t *testing.T) {
+	issueA := result.Issue{
+		FromLinter:  "misspell",
+		Text:        "`iff` is a misspelling of `if`",
+		SourceLines: []string{"// iff"},
+		Pos:         token.Position{Filename: "a.go", Line: 11, Column: 8},
+	}
+
+	issueB := result.Issue{
+		FromLinter:  "errcheck",
+		Text:        "Error return value is not checked",
+		SourceLines: []string{"\tf()"},
+		Pos:         token.Position{Filename: "b.go", Line: 3, Column: 2},
+	}
+
+	// Same position and message, but another linter.
+	issueC := issueB
+	issueC.FromLinter = "govet"
+
+	results := []printers.JSONResult{
+		{
+			Issues: []result.Issue{issueA, issueB},
+			Report: &report.Data{
+				Version:  "v1.0.0",
+				Warnings: []report.Warning{{Tag: "runner", Text: "a"}},
+				Linters: []report.LinterData{
+					{Name: "errcheck", Enabled: true},
+					{Name: "govet"},
+				},
+				Error: "timeout",
+			},
+		},
+		{
+			Issues: []result.Issue{issueB, issueC},
+			Report: &report.Data{
+				Version:  "v1.0.1",
+				Warnings: []report.Warning{{Tag: "runner", Text: "a"}, {Tag: "runner", Text: "b"}},
+				Linters: []report.LinterData{
+					{Name: "errcheck"},
+					{Name: "govet", Enabled: true},
+				},
+				HiddenIssues: []result.HiddenIssue{{Issue: issueA, Processor: "nolint"}},
+				Error:        "timeout",
+				ExitCode:     1,
+			},
+		},
+	}
+
+	issues, reportData := mergeResults(results)
+
+	assert.Equal(t, []result.Issue{issueA, issueB, issueC}, issues)
+
+	expected := &report.Data{
+		Version:  "v1.0.0",
+		Warnings: []report.Warning{{Tag: "runner", Text: "a"}, {Tag: "runner", Text: "b"}},
+		Linters: []report.LinterData{
+			{Name: "errcheck", Enabled: true},
+			{Name: "govet", Enabled: true},
+		},
+		HiddenIssues: []result.HiddenIssue{{Issue: issueA, Processor: "nolint"}},
+		Error:        "timeout",
+		ExitCode:     1,
+	}
+
+	assert.Equal(t, expected, reportData)
+}
+
+func Test_readJSONResult(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "result.json")
+
+	content := `{"Issues":[{"FromLinter":"misspell","Text":"typo","Pos":{"Filename":"a.go","Line":1,"Column":2}}]}`
+
+	err := os.WriteFile(path, []byte(content), 0o600)
+	require.NoError(t, err)
+
+	res, err := readJSONResult(path)
+	require.NoError(t, err)
+
+	require.Len(t, res.Issues, 1)
+	assert.Equal(t, "misspell", res.Issues[0].FromLinter)
+	assert.Equal(t, &report.Data{}, res.Report)
+}
+
+func Test_readJSONResult_error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "result.json")
+
+	err := os.WriteFile(path, []byte("issue"), 0o600)
+	require.NoError(t, err)
+
+	_, err = readJSONResult(path)
+	require.ErrorContains(t, err, "can't decode the results")
+}
@@ -62,6 +62,7 @@ func newRootCommand(info BuildInfo) *rootCommand {
 		newRunCommand(log, info).cmd,
 		newCacheCommand().cmd,
 		newConfigCommand(log, info).cmd,
+		newReportCommand(log).cmd,
 		newVersionCommand(info).cmd,
 		newCustomCommand(log).cmd,
 	)
@@ -66,6 +66,10 @@ type runOptions struct {
 	TracePath      string // Flag only.
 
 	PrintResourcesUsage bool // Flag only.
+
+	AddNolint string // Flag only.
+
+	Suppressions string // Flag only.
 }
 
 type runCommand struct {
@@ -82,6 +86,8 @@ type runCommand struct {
 
 	printer *printers.Printer
 
+	suppressions []result.Suppression
+
 	log        logutils.Log
 	debugf     logutils.DebugFunc
 	reportData *report.Data
@@ -94,11 +100,14 @@ type runCommand struct {
 
 	flock *flock.Flock
 
+	// unusedRules are the exclude rules and severity rules that didn't match any issue.
+	unusedRules []string
+
 	exitCode int
 }
 
 func newRunCommand(logger logutils.Log, info BuildInfo) *runCommand {
-	reportData := &report.Data{}
+	reportData := &report.Data{Version: info.Version}
 
 	c := &runCommand{
 		viper:      viper.New(),
@@ -139,6 +148,14 @@ func newRunCommand(logger logutils.Log, info BuildInfo) *runCommand {
 	setupOutputFlagSet(c.viper, fs)
 	setupIssuesFlagSet(c.viper, fs)
 
+	fs.StringVar(&c.opts.AddNolint, "add-nolint", "",
+		color.GreenString("Add nolint directives for the reported issues, with an optional `reason` (--add-nolint=reason)"))
+	fs.Lookup("add-nolint").NoOptDefVal = " "
+
+	fs.StringVar(&c.opts.Suppressions, "suppressions", "",
+		color.GreenString("Print the report of the issues hidden by the nolint directives, the exclusions and the limits "+
+			"(`format[:path]`, the format is text or json)"))
+
 	setupRunPersistentFlags(runCmd.PersistentFlags(), &c.opts)
 
 	c.cmd = runCmd
@@ -160,6 +177,20 @@ func (c *runCommand) persistentPreRunE(cmd *cobra.Command, args []string) error
 		return fmt.Errorf("can't load config: %w", err)
 	}
 
+	if cmd.Flags().Changed("add-nolint") {
+		c.cfg.Issues.AddNolint = true
+		c.cfg.Issues.AddNolintReason = strings.TrimSpace(c.opts.AddNolint)
+
+		// The nolint directives must be added for all the issues at once.
+		c.cfg.Issues.MaxIssuesPerLinter = 0
+		c.cfg.Issues.MaxSameIssues = 0
+	}
+
+	if format, _, _ := strings.Cut(c.opts.Suppressions, ":"); format != "" &&
+		format != printers.SuppressionsFormatText && format != printers.SuppressionsFormatJSON {
+		return fmt.Errorf("unsupported suppressions format %q", format)
+	}
+
 	if c.cfg.Run.Concurrency == 0 {
 		backup := runtime.GOMAXPROCS(0)
 
@@ -347,15 +378,22 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 
 	issues, err := c.runAnalysis(ctx, args)
 	if err != nil {
+		if errClose := c.printer.CloseStreams(err); errClose != nil {
+			c.log.Warnf("Can't close the streaming outputs: %v", errClose)
+		}
+
 		return err // XXX: don't lose type
 	}
 
 	// Fills linters information for the JSON printer.
 	for _, lc := range c.dbManager.GetAllSupportedLinterConfigs() {
 		isEnabled := enabledLintersMap[lc.Name()] != nil
-		c.reportData.AddLinter(lc.Name(), isEnabled, lc.EnabledByDefault)
+		c.reportData.AddLinter(lc.Name(), lc.Linter.Desc(), lc.OriginalURL, isEnabled, lc.EnabledByDefault)
 	}
 
+	// Used by the SARIF printer.
+	c.reportData.ExitCode = c.expectedExitCode(issues)
+
 	err = c.printer.Print(issues)
 	if err != nil {
 		return err
@@ -363,8 +401,21 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 
 	c.printStats(issues)
 
+	c.printHiddenIssues()
+
+	if c.opts.Suppressions != "" {
+		format, path, _ := strings.Cut(c.opts.Suppressions, ":")
+
+		err = c.printer.PrintSuppressions(c.suppressions, &config.OutputFormat{Format: format, Path: path})
+		if err != nil {
+			return err
+		}
+	}
+
 	c.setExitCodeIfIssuesFound(issues)
 
+	c.setExitCodeIfUnusedRules()
+
 	c.fileCache.PrintStats(c.log)
 
 	return nil
@@ -388,7 +439,18 @@ func (c *runCommand) runAnalysis(ctx context.Context, args []string) ([]result.I
 		return nil, err
 	}
 
-	return runner.Run(ctx, lintersToRun)
+	// The fixes are applied at the end, so the issues can't be printed while the linters are running.
+	if c.printer.CanStream() && !c.cfg.Issues.NeedFix && !c.cfg.Issues.AddNolint {
+		runner.SetIssuesHandler(c.printer.Stream)
+	}
+
+	issues, err := runner.Run(ctx, lintersToRun)
+
+	c.suppressions = runner.Suppressions()
+	c.unusedRules = runner.UnusedRules()
+	c.reportData.HiddenIssues = runner.HiddenIssues()
+
+	return issues, err
 }
 
 func (c *runCommand) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
@@ -403,12 +465,44 @@ func (c *runCommand) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
 	return
 }
 
+// expectedExitCode returns the exit code of the run if the results are printed without error.
+// It follows the steps of setExitCodeIfIssuesFound, setExitCodeIfUnusedRules and setupExitCode.
+func (c *runCommand) expectedExitCode(issues []result.Issue) int {
+	exitCode := c.exitCode
+
+	if len(issues) != 0 {
+		exitCode = c.cfg.Run.ExitCodeIfIssuesFound
+	}
+
+	if c.failOnUnusedRules() {
+		exitCode = exitcodes.Failure
+	}
+
+	return c.finalExitCode(exitCode)
+}
+
 func (c *runCommand) setExitCodeIfIssuesFound(issues []result.Issue) {
 	if len(issues) != 0 {
 		c.exitCode = c.cfg.Run.ExitCodeIfIssuesFound
 	}
 }
 
+// setExitCodeIfUnusedRules fails the run if some rules are unused (`issues.fail-on-unused-rules`).
+// The issues are printed anyway.
+func (c *runCommand) setExitCodeIfUnusedRules() {
+	if !c.failOnUnusedRules() {
+		return
+	}
+
+	c.log.Errorf("Unused rules: %s", strings.Join(c.unusedRules, ", "))
+
+	c.exitCode = exitcodes.Failure
+}
+
+func (c *runCommand) failOnUnusedRules() bool {
+	return c.cfg.Issues.FailOnUnusedRules && len(c.unusedRules) != 0
+}
+
 func (c *runCommand) printDeprecatedLinterMessages(enabledLinters map[string]*linter.Config) {
 	if c.cfg.InternalCmdTest || os.Getenv(logutils.EnvTestRun) == "1" {
 		return
@@ -453,6 +547,37 @@ func (c *runCommand) printStats(issues []result.Issue) {
 	}
 }
 
+func (c *runCommand) printHiddenIssues() {
+	if !c.cfg.Output.ExplainHidden {
+		return
+	}
+
+	// The hidden issues are already inside the JSON output.
+	for i := range c.cfg.Output.Formats {
+		format := &c.cfg.Output.Formats[i]
+		if format.Format == config.OutFormatJSON && (format.Path == "" || format.Path == "stdout") {
+			return
+		}
+	}
+
+	hidden := c.reportData.HiddenIssues
+
+	c.cmd.Printf("%d hidden issues:\n", len(hidden))
+
+	for i := range hidden {
+		h := &hidden[i]
+
+		c.cmd.Printf("%s: %s (%s)\n", h.Issue.Pos, h.Issue.Text, h.Issue.FromLinter)
+
+		explanation := fmt.Sprintf("  hidden by %s %q (processor %s)", h.Kind, h.Name, h.Processor)
+		if h.Reason != "" {
+			explanation += ": " + h.Reason
+		}
+
+		c.cmd.Println(explanation)
+	}
+}
+
 func (c *runCommand) setupExitCode(ctx context.Context) {
 	if ctx.Err() != nil {
 		c.exitCode = exitcodes.Timeout
@@ -460,21 +585,26 @@ func (c *runCommand) setupExitCode(ctx context.Context) {
 		return
 	}
 
-	if c.exitCode != exitcodes.Success {
-		return
+	c.exitCode = c.finalExitCode(c.exitCode)
+}
+
+// finalExitCode returns the exit code of the run: the exit code already set, or the exit code related to the logs.
+func (c *runCommand) finalExitCode(exitCode int) int {
+	if exitCode != exitcodes.Success {
+		return exitCode
 	}
 
 	needFailOnWarnings := os.Getenv(logutils.EnvTestRun) == "1" || os.Getenv(envFailOnWarnings) == "1"
 	if needFailOnWarnings && len(c.reportData.Warnings) != 0 {
-		c.exitCode = exitcodes.WarningInTest
-		return
+		return exitcodes.WarningInTest
 	}
 
 	if c.reportData.Error != "" {
 		// it's a case e.g. when typecheck linter couldn't parse and error and just logged it
-		c.exitCode = exitcodes.ErrorWasLogged
-		return
+		return exitcodes.ErrorWasLogged
 	}
+
+	return exitcodes.Success
 }
 
 func (c *runCommand) acquireFileLock() bool {
@@ -575,6 +705,7 @@ func watchResources(ctx context.Context, done chan struct{}, logger logutils.Log
 func setupConfigFileFlagSet(fs *pflag.FlagSet, cfg *config.LoaderOptions) {
 	fs.StringVarP(&cfg.Config, "config", "c", "", color.GreenString("Read config from file path `PATH`"))
 	fs.BoolVar(&cfg.NoConfig, "no-config", false, color.GreenString("Don't read config file"))
+	fs.BoolVar(&cfg.StrictConfig, "strict-config", false, color.GreenString("Fail on unknown configuration options"))
 }
 
 func setupRunPersistentFlags(fs *pflag.FlagSet, opts *runOptions) {
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	hcversion "github.com/hashicorp/go-version"
 	"github.com/ldez/grignotin/gomod"
@@ -43,6 +44,7 @@ func (c *Config) Validate() error {
 		c.Linters.Validate,
 		c.Issues.Validate,
 		c.Severity.Validate,
+		c.validateMinSeverity,
 	}
 
 	for _, v := range validators {
@@ -54,6 +56,59 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// validateMinSeverity checks that the issues have a severity when an output filters them by severity:
+// the issues without severity would always be hidden.
+func (c *Config) validateMinSeverity() error {
+	if SeverityRank(c.Severity.Default) > 0 {
+		return nil
+	}
+
+	for i := range c.Output.Formats {
+		if c.Output.Formats[i].MinSeverity != "" {
+			return fmt.Errorf("output.formats[%d]: min-severity requires a common severity in severity.default-severity (e.g. 'warning')", i)
+		}
+	}
+
+	return nil
+}
+
+// ExpiringRule is an exclude rule or a severity rule with an expiration date.
+type ExpiringRule struct {
+	Section string // The configuration option containing the rule.
+	Index   int
+	Rule    *BaseRule
+}
+
+func (r ExpiringRule) String() string {
+	s := fmt.Sprintf("%s[%d] (expires: %s)", r.Section, r.Index, r.Rule.Expires)
+	if r.Rule.Reason != "" {
+		s += fmt.Sprintf(" (reason: %s)", r.Rule.Reason)
+	}
+
+	return s
+}
+
+// GetExpiredRules returns the exclude rules and the severity rules that are expired at the given time.
+func (c *Config) GetExpiredRules(at time.Time) []ExpiringRule {
+	var rules []ExpiringRule
+
+	for i := range c.Issues.ExcludeRules {
+		rule := &c.Issues.ExcludeRules[i].BaseRule
+		if rule.IsExpired(at) {
+			rules = append(rules, ExpiringRule{Section: "issues.exclude-rules", Index: i, Rule: rule})
+		}
+	}
+
+	for i := range c.Severity.Rules {
+		rule := &c.Severity.Rules[i].BaseRule
+		if rule.IsExpired(at) {
+			rules = append(rules, ExpiringRule{Section: "severity.rules", Index: i, Rule: rule})
+		}
+	}
+
+	return rules
+}
+
 func NewDefault() *Config {
 	return &Config{
 		LintersSettings: defaultLintersSettings,
@@ -4,8 +4,28 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func TestConfig_validateMinSeverity(t *testing.T) {
+	cfg := &Config{
+		Output: Output{Formats: OutputFormats{{Format: "json"}, {Format: "sarif", MinSeverity: "warning"}}},
+	}
+
+	err := cfg.validateMinSeverity()
+	require.EqualError(t, err, "output.formats[1]: min-severity requires a common severity in severity.default-severity (e.g. 'warning')")
+
+	cfg.Severity.Default = "@linter"
+
+	err = cfg.validateMinSeverity()
+	require.Error(t, err)
+
+	cfg.Severity.Default = "info"
+
+	err = cfg.validateMinSeverity()
+	require.NoError(t, err)
+}
+
 func TestIsGoGreaterThanOrEqual(t *testing.T) {
 	testCases := []struct {
 		desc    string
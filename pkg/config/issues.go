@@ -4,10 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"time"
 )
 
 const excludeRuleMinConditionsCount = 2
 
+// RuleExpiresLayout is the layout of the expiration date of the rules.
+const RuleExpiresLayout = time.DateOnly
+
 var DefaultExcludePatterns = []ExcludePattern{
 	{
 		ID: "EXC0001",
@@ -110,13 +114,21 @@ type Issues struct {
 	ExcludeRules           []ExcludeRule `mapstructure:"exclude-rules"`
 	UseDefaultExcludes     bool          `mapstructure:"exclude-use-default"`
 
-	ExcludeGenerated string `mapstructure:"exclude-generated"`
+	ExcludeGenerated string   `mapstructure:"exclude-generated"`
+	GeneratedMarkers []string `mapstructure:"generated-markers"`
+	GeneratedFiles   []string `mapstructure:"generated-files"`
+	LintGenerated    []string `mapstructure:"lint-generated"`
 
 	ExcludeFiles []string `mapstructure:"exclude-files"`
 	ExcludeDirs  []string `mapstructure:"exclude-dirs"`
 
 	UseDefaultExcludeDirs bool `mapstructure:"exclude-dirs-use-default"`
 
+	// MergeEquivalentIssues merges the issues reported on the same line by different linters for the same defect.
+	MergeEquivalentIssues bool `mapstructure:"merge-equivalent-issues"`
+	// EquivalentIssues are groups of equivalent rules (`linter` or `linter(rule)`), in addition to the built-in groups.
+	EquivalentIssues [][]string `mapstructure:"equivalent-issues"`
+
 	MaxIssuesPerLinter int `mapstructure:"max-issues-per-linter"`
 	MaxSameIssues      int `mapstructure:"max-same-issues"`
 
@@ -127,13 +139,27 @@ type Issues struct {
 
 	NeedFix bool `mapstructure:"fix"`
 
+	// AddNolint is set by the flag `--add-nolint[=reason]` (flag only):
+	// the issues are not reported, a nolint directive is added for each of them.
+	AddNolint       bool   `mapstructure:"-"`
+	AddNolintReason string `mapstructure:"-"`
+
+	FailOnExpiredRules bool `mapstructure:"fail-on-expired-rules"`
+	FailOnUnusedRules  bool `mapstructure:"fail-on-unused-rules"`
+
 	ExcludeGeneratedStrict bool `mapstructure:"exclude-generated-strict"` // Deprecated: use ExcludeGenerated instead.
 }
 
 func (i *Issues) Validate() error {
-	for i, rule := range i.ExcludeRules {
-		if err := rule.Validate(); err != nil {
-			return fmt.Errorf("error in exclude rule #%d: %w", i, err)
+	for j := range i.ExcludeRules {
+		if err := i.ExcludeRules[j].Validate(); err != nil {
+			return fmt.Errorf("error in exclude rule #%d: %w", j, err)
+		}
+	}
+
+	for j, group := range i.EquivalentIssues {
+		if len(group) < 2 {
+			return fmt.Errorf("error in equivalent issues #%d: at least 2 rules should be set", j)
 		}
 	}
 
@@ -154,23 +180,61 @@ type BaseRule struct {
 	PathExcept string `mapstructure:"path-except"`
 	Text       string
 	Source     string
+
+	// Symbol matches the name of the function, the method (`Type.Method`), or the type enclosing the issue.
+	Symbol string
+	// Package matches the import path of the package of the issue.
+	Package string
+
+	// Expires is the last day (YYYY-MM-DD) the rule is applied.
+	Expires string
+	// Reason explains why the rule exists, for documentation purposes only.
+	Reason string
 }
 
-func (b *BaseRule) Validate(minConditionsCount int) error {
-	if err := validateOptionalRegex(b.Path); err != nil {
-		return fmt.Errorf("invalid path regex: %w", err)
+// ExpirationDate returns the date after which the rule is no longer applied,
+// and false if the rule doesn't expire.
+func (b *BaseRule) ExpirationDate() (time.Time, bool) {
+	if b.Expires == "" {
+		return time.Time{}, false
 	}
 
-	if err := validateOptionalRegex(b.PathExcept); err != nil {
-		return fmt.Errorf("invalid path-except regex: %w", err)
+	date, err := time.ParseInLocation(RuleExpiresLayout, b.Expires, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// The rule is applied until the end of the expiration day.
+	return date.AddDate(0, 0, 1), true
+}
+
+// IsExpired reports whether the rule is expired at the given time.
+func (b *BaseRule) IsExpired(at time.Time) bool {
+	date, ok := b.ExpirationDate()
+
+	return ok && !at.Before(date)
+}
+
+func (b *BaseRule) Validate(minConditionsCount int) error {
+	if b.Expires != "" {
+		if _, err := time.Parse(RuleExpiresLayout, b.Expires); err != nil {
+			return fmt.Errorf("invalid expires date (expected format YYYY-MM-DD): %w", err)
+		}
 	}
 
-	if err := validateOptionalRegex(b.Text); err != nil {
-		return fmt.Errorf("invalid text regex: %w", err)
+	regexes := []struct{ name, value string }{
+		{name: "path", value: b.Path},
+		{name: "path-except", value: b.PathExcept},
+		{name: "text", value: b.Text},
+		{name: "source", value: b.Source},
+		{name: "symbol", value: b.Symbol},
+		{name: "package", value: b.Package},
 	}
 
-	if err := validateOptionalRegex(b.Source); err != nil {
-		return fmt.Errorf("invalid source regex: %w", err)
+	for _, r := range regexes {
+		if err := validateOptionalRegex(r.value); err != nil {
+			return fmt.Errorf("invalid %s regex: %w", r.name, err)
+		}
 	}
 
 	if b.Path != "" && b.PathExcept != "" {
@@ -197,8 +261,16 @@ func (b *BaseRule) Validate(minConditionsCount int) error {
 		nonBlank++
 	}
 
+	if b.Symbol != "" {
+		nonBlank++
+	}
+
+	if b.Package != "" {
+		nonBlank++
+	}
+
 	if nonBlank < minConditionsCount {
-		return fmt.Errorf("at least %d of (text, source, path[-except],  linters) should be set", minConditionsCount)
+		return fmt.Errorf("at least %d of (text, source, symbol, package, path[-except],  linters) should be set", minConditionsCount)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,7 +35,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc:     "empty rule",
 			rule:     &ExcludeRule{},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, symbol, package, path[-except],  linters) should be set",
 		},
 		{
 			desc: "only path rule",
@@ -43,7 +44,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 					Path: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, symbol, package, path[-except],  linters) should be set",
 		},
 		{
 			desc: "only path-except rule",
@@ -52,7 +53,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 					PathExcept: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, symbol, package, path[-except],  linters) should be set",
 		},
 		{
 			desc: "only text rule",
@@ -61,7 +62,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 					Text: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, symbol, package, path[-except],  linters) should be set",
 		},
 		{
 			desc: "only source rule",
@@ -70,7 +71,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 					Source: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, symbol, package, path[-except],  linters) should be set",
 		},
 		{
 			desc: "invalid path rule",
@@ -108,6 +109,24 @@ func TestExcludeRule_Validate(t *testing.T) {
 			},
 			expected: "invalid source regex: error parsing regexp: missing argument to repetition operator: `*`",
 		},
+		{
+			desc: "invalid symbol rule",
+			rule: &ExcludeRule{
+				BaseRule{
+					Symbol: "**test",
+				},
+			},
+			expected: "invalid symbol regex: error parsing regexp: missing argument to repetition operator: `*`",
+		},
+		{
+			desc: "invalid package rule",
+			rule: &ExcludeRule{
+				BaseRule{
+					Package: "**test",
+				},
+			},
+			expected: "invalid package regex: error parsing regexp: missing argument to repetition operator: `*`",
+		},
 		{
 			desc: "path and path-expect",
 			rule: &ExcludeRule{
@@ -118,6 +137,17 @@ func TestExcludeRule_Validate(t *testing.T) {
 			},
 			expected: "path and path-except should not be set at the same time",
 		},
+		{
+			desc: "invalid expires",
+			rule: &ExcludeRule{
+				BaseRule{
+					Text:    "test",
+					Linters: []string{"a"},
+					Expires: "31/12/2026",
+				},
+			},
+			expected: `invalid expires date (expected format YYYY-MM-DD): parsing time "31/12/2026" as "2006-01-02": cannot parse "31/12/2026" as "2006"`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -171,6 +201,24 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "symbol and linter",
+			rule: &ExcludeRule{
+				BaseRule{
+					Symbol:  "^TestMain$",
+					Linters: []string{"a"},
+				},
+			},
+		},
+		{
+			desc: "package and linter",
+			rule: &ExcludeRule{
+				BaseRule{
+					Package: "/metrics$",
+					Linters: []string{"a"},
+				},
+			},
+		},
 		{
 			desc: "path and text",
 			rule: &ExcludeRule{
@@ -190,6 +238,17 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "expires and reason",
+			rule: &ExcludeRule{
+				BaseRule{
+					Text:    "test",
+					Linters: []string{"a"},
+					Expires: "2026-12-31",
+					Reason:  "test",
+				},
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -201,3 +260,13 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseRule_IsExpired(t *testing.T) {
+	rule := &BaseRule{Expires: "2026-12-31"}
+
+	assert.False(t, rule.IsExpired(time.Date(2026, 12, 30, 12, 0, 0, 0, time.Local)))
+	assert.False(t, rule.IsExpired(time.Date(2026, 12, 31, 23, 59, 0, 0, time.Local)))
+	assert.True(t, rule.IsExpired(time.Date(2027, 1, 1, 0, 0, 0, 0, time.Local)))
+
+	assert.False(t, (&BaseRule{}).IsExpired(time.Now()))
+}
@@ -294,6 +294,8 @@ type LintersSettings struct {
 	WSL             WSLSettings
 
 	Custom map[string]CustomLinterSettings
+
+	Overrides []LintersSettingsOverride
 }
 
 func (s *LintersSettings) Validate() error {
@@ -301,12 +303,22 @@ func (s *LintersSettings) Validate() error {
 		return err
 	}
 
+	if err := s.NoLintLint.Validate(); err != nil {
+		return err
+	}
+
 	for name, settings := range s.Custom {
 		if err := settings.Validate(); err != nil {
 			return fmt.Errorf("custom linter %q: %w", name, err)
 		}
 	}
 
+	for i, override := range s.Overrides {
+		if err := override.Validate(s); err != nil {
+			return fmt.Errorf("error in linters settings override #%d: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -771,6 +783,16 @@ type NoLintLintSettings struct {
 	RequireSpecific    bool     `mapstructure:"require-specific"`
 	AllowNoExplanation []string `mapstructure:"allow-no-explanation"`
 	AllowUnused        bool     `mapstructure:"allow-unused"`
+	ExplanationPattern string   `mapstructure:"explanation-pattern"`
+	RequireExpiration  bool     `mapstructure:"require-expiration"`
+}
+
+func (s *NoLintLintSettings) Validate() error {
+	if err := validateOptionalRegex(s.ExplanationPattern); err != nil {
+		return fmt.Errorf("nolintlint: invalid explanation-pattern: %w", err)
+	}
+
+	return nil
 }
 
 type NoNamedReturnsSettings struct {
@@ -910,9 +932,9 @@ type TagliatelleBase struct {
 }
 
 type TagliatelleExtendedRule struct {
-	Case                string
-	ExtraInitialisms    bool
-	InitialismOverrides map[string]bool
+	Case                string          `mapstructure:"case"`
+	ExtraInitialisms    bool            `mapstructure:"extra-initialisms"`
+	InitialismOverrides map[string]bool `mapstructure:"initialism-overrides"`
 }
 
 type TestifylintSettings struct {
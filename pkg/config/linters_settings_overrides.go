@@ -0,0 +1,157 @@
+package config
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+)
+
+// OverridableLinters are the linters whose settings can be overridden for a subset of the files.
+var OverridableLinters = []string{"dupl", "funlen", "gocognit", "lll", "varnamelen"}
+
+// LintersSettingsOverride defines linters settings applied only to the files matching the path (or the glob).
+// The settings are merged with the settings defined at the root of `linters-settings`.
+type LintersSettingsOverride struct {
+	Path string `mapstructure:"path"`
+	Glob string `mapstructure:"glob"`
+
+	Settings map[string]any `mapstructure:",remain"`
+}
+
+func (o *LintersSettingsOverride) Validate(base *LintersSettings) error {
+	if o.Path == "" && o.Glob == "" {
+		return errors.New("path or glob should be set")
+	}
+
+	if o.Path != "" && o.Glob != "" {
+		return errors.New("path and glob should not be set at the same time")
+	}
+
+	if _, err := regexp.Compile(o.PathPattern()); err != nil {
+		return fmt.Errorf("invalid path: %w", err)
+	}
+
+	if len(o.Settings) == 0 {
+		return errors.New("at least one linter should be configured")
+	}
+
+	for name := range o.Settings {
+		if !slices.Contains(OverridableLinters, name) {
+			return fmt.Errorf("the settings of %q cannot be overridden, supported linters: %s",
+				name, strings.Join(OverridableLinters, ", "))
+		}
+	}
+
+	if _, err := o.Apply(base); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Linters returns the names of the linters configured by the override.
+func (o *LintersSettingsOverride) Linters() []string {
+	names := maps.Keys(o.Settings)
+	slices.Sort(names)
+
+	return names
+}
+
+// PathPattern returns the regular expression used to match the file paths (relative to the working directory, with `/` as separator).
+func (o *LintersSettingsOverride) PathPattern() string {
+	if o.Glob != "" {
+		return GlobToRegex(o.Glob)
+	}
+
+	return o.Path
+}
+
+// ID returns a stable identifier of the override, based on its content.
+func (o *LintersSettingsOverride) ID() string {
+	raw, err := yaml.Marshal(o)
+	if err != nil {
+		raw = []byte(fmt.Sprintf("%#v", o))
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(raw))[:16]
+}
+
+// Apply returns a copy of the base settings, with the settings of the override applied on top of it.
+func (o *LintersSettingsOverride) Apply(base *LintersSettings) (*LintersSettings, error) {
+	return o.apply(base, nil)
+}
+
+// unusedKeys returns the settings of the override that don't match any option.
+func (o *LintersSettingsOverride) unusedKeys(base *LintersSettings) ([]string, error) {
+	var md mapstructure.Metadata
+
+	_, err := o.apply(base, &md)
+	if err != nil {
+		return nil, err
+	}
+
+	return md.Unused, nil
+}
+
+func (o *LintersSettingsOverride) apply(base *LintersSettings, md *mapstructure.Metadata) (*LintersSettings, error) {
+	settings := *base
+	settings.Overrides = nil
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       decodeHooks(),
+		WeaklyTypedInput: true,
+		// Allocates new slices, maps, and pointers: the base settings must not be modified.
+		ZeroFields: true,
+		Metadata:   md,
+		Result:     &settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(o.Settings); err != nil {
+		return nil, fmt.Errorf("can't decode settings: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// GlobToRegex converts a glob into a regular expression.
+// `**` matches any number of directories, `*` and `?` don't match `/`.
+// The glob can match any trailing part of a path: `*_test.go` matches all the test files.
+func GlobToRegex(glob string) string {
+	b := strings.Builder{}
+	b.WriteString("(^|/)")
+
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			b.WriteString("(.*/)?")
+			i += 2
+
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+
+		case glob[i] == '*':
+			b.WriteString("[^/]*")
+
+		case glob[i] == '?':
+			b.WriteString("[^/]")
+
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	b.WriteString("$")
+
+	return b.String()
+}
@@ -0,0 +1,185 @@
+package config
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintersSettingsOverride_Validate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		override *LintersSettingsOverride
+	}{
+		{
+			desc: "path",
+			override: &LintersSettingsOverride{
+				Path:     `_test\.go`,
+				Settings: map[string]any{"funlen": map[string]any{"lines": 100}},
+			},
+		},
+		{
+			desc: "glob",
+			override: &LintersSettingsOverride{
+				Glob: "internal/legacy/**",
+				Settings: map[string]any{
+					"lll":        map[string]any{"line-length": 200},
+					"varnamelen": map[string]any{"ignore-names": []any{"i", "j"}},
+				},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.override.Validate(&defaultLintersSettings)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLintersSettingsOverride_Validate_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		override *LintersSettingsOverride
+		expected string
+	}{
+		{
+			desc: "no path",
+			override: &LintersSettingsOverride{
+				Settings: map[string]any{"funlen": map[string]any{"lines": 100}},
+			},
+			expected: "path or glob should be set",
+		},
+		{
+			desc: "path and glob",
+			override: &LintersSettingsOverride{
+				Path:     `_test\.go`,
+				Glob:     "*_test.go",
+				Settings: map[string]any{"funlen": map[string]any{"lines": 100}},
+			},
+			expected: "path and glob should not be set at the same time",
+		},
+		{
+			desc: "invalid path",
+			override: &LintersSettingsOverride{
+				Path:     "**",
+				Settings: map[string]any{"funlen": map[string]any{"lines": 100}},
+			},
+			expected: "invalid path: error parsing regexp: missing argument to repetition operator: `*`",
+		},
+		{
+			desc: "no settings",
+			override: &LintersSettingsOverride{
+				Path: `_test\.go`,
+			},
+			expected: "at least one linter should be configured",
+		},
+		{
+			desc: "unsupported linter",
+			override: &LintersSettingsOverride{
+				Path:     `_test\.go`,
+				Settings: map[string]any{"govet": map[string]any{"enable-all": true}},
+			},
+			expected: `the settings of "govet" cannot be overridden, supported linters: dupl, funlen, gocognit, lll, varnamelen`,
+		},
+		{
+			desc: "invalid settings",
+			override: &LintersSettingsOverride{
+				Path:     `_test\.go`,
+				Settings: map[string]any{"funlen": map[string]any{"lines": "foo"}},
+			},
+			expected: "can't decode settings: decoding failed due to the following error(s):\n\n" +
+				`cannot parse 'Funlen.Lines' as int: strconv.ParseInt: parsing "foo": invalid syntax`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.override.Validate(&defaultLintersSettings)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestLintersSettingsOverride_Apply(t *testing.T) {
+	base := &LintersSettings{
+		Funlen: FunlenSettings{Lines: 60, Statements: 40},
+		Lll:    LllSettings{LineLength: 120, TabWidth: 1},
+		Varnamelen: VarnamelenSettings{
+			MinNameLength: 3,
+			IgnoreNames:   []string{"err"},
+		},
+	}
+
+	override := &LintersSettingsOverride{
+		Path: `_test\.go`,
+		Settings: map[string]any{
+			"funlen":     map[string]any{"lines": 100},
+			"varnamelen": map[string]any{"ignore-names": []any{"tt"}},
+		},
+	}
+
+	settings, err := override.Apply(base)
+	require.NoError(t, err)
+
+	assert.Equal(t, FunlenSettings{Lines: 100, Statements: 40}, settings.Funlen)
+	assert.Equal(t, LllSettings{LineLength: 120, TabWidth: 1}, settings.Lll)
+	assert.Equal(t, VarnamelenSettings{MinNameLength: 3, IgnoreNames: []string{"tt"}}, settings.Varnamelen)
+
+	// The base settings must not be modified.
+	assert.Equal(t, FunlenSettings{Lines: 60, Statements: 40}, base.Funlen)
+	assert.Equal(t, []string{"err"}, base.Varnamelen.IgnoreNames)
+}
+
+func TestLintersSettingsOverride_PathPattern(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		glob     string
+		match    []string
+		notMatch []string
+	}{
+		{
+			desc:     "file suffix",
+			glob:     "*_test.go",
+			match:    []string{"foo_test.go", "pkg/foo_test.go"},
+			notMatch: []string{"foo.go", "foo_test.go.txt"},
+		},
+		{
+			desc:     "directory",
+			glob:     "internal/legacy/**",
+			match:    []string{"internal/legacy/foo.go", "internal/legacy/a/b/foo.go", "pkg/internal/legacy/foo.go"},
+			notMatch: []string{"internal/foo.go", "internal/legacyfoo/foo.go"},
+		},
+		{
+			desc:     "any directory",
+			glob:     "pkg/**/api/*.go",
+			match:    []string{"pkg/api/foo.go", "pkg/a/b/api/foo.go"},
+			notMatch: []string{"pkg/api/v1/foo.go"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			override := &LintersSettingsOverride{Glob: test.glob}
+
+			re := regexp.MustCompile(override.PathPattern())
+
+			for _, p := range test.match {
+				assert.True(t, re.MatchString(p), p)
+			}
+
+			for _, p := range test.notMatch {
+				assert.False(t, re.MatchString(p), p)
+			}
+		})
+	}
+}
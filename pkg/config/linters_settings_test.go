@@ -30,6 +30,14 @@ func TestLintersSettings_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "nolintlint",
+			settings: &LintersSettings{
+				NoLintLint: NoLintLintSettings{
+					ExplanationPattern: `JIRA-[0-9]+`,
+				},
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -70,6 +78,15 @@ func TestLintersSettings_Validate_error(t *testing.T) {
 			},
 			expected: "govet: enable-all and disable-all can't be combined",
 		},
+		{
+			desc: "nolintlint error",
+			settings: &LintersSettings{
+				NoLintLint: NoLintLintSettings{
+					ExplanationPattern: "JIRA-[0-9+",
+				},
+			},
+			expected: "nolintlint: invalid explanation-pattern: error parsing regexp: missing closing ]: `[0-9+`",
+		},
 	}
 
 	for _, test := range testCases {
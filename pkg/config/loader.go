@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/mitchellh/go-homedir"
@@ -22,13 +25,16 @@ import (
 var errConfigDisabled = errors.New("config is disabled by --no-config")
 
 type LoaderOptions struct {
-	Config   string // Flag only. The path to the golangci config file, as specified with the --config argument.
-	NoConfig bool   // Flag only.
+	Config       string // Flag only. The path to the golangci config file, as specified with the --config argument.
+	NoConfig     bool   // Flag only.
+	StrictConfig bool   // Flag only. Unknown options are errors instead of warnings.
 }
 
 type LoadOptions struct {
 	CheckDeprecation bool
 	Validation       bool
+	// Strict reports the unknown options as errors instead of warnings.
+	Strict bool
 }
 
 type Loader struct {
@@ -41,6 +47,8 @@ type Loader struct {
 
 	cfg  *Config
 	args []string
+
+	unknownKeys []unknownKey
 }
 
 func NewLoader(log logutils.Log, v *viper.Viper, fs *pflag.FlagSet, opts LoaderOptions, cfg *Config, args []string) *Loader {
@@ -65,6 +73,11 @@ func (l *Loader) Load(opts LoadOptions) error {
 		return err
 	}
 
+	err = l.handleUnknownKeys(opts.Strict || l.opts.StrictConfig)
+	if err != nil {
+		return err
+	}
+
 	l.applyStringSliceHack()
 
 	if opts.CheckDeprecation {
@@ -96,6 +109,49 @@ func (l *Loader) Load(opts LoadOptions) error {
 	return nil
 }
 
+// LoadOutput loads only the output section of the configuration (file and flags):
+// the other sections are ignored, so they are neither decoded nor validated.
+func (l *Loader) LoadOutput() error {
+	err := l.setConfigFile()
+	if err != nil {
+		return err
+	}
+
+	err = l.viper.ReadInConfig()
+	if err != nil {
+		var configFileNotFoundError viper.ConfigFileNotFoundError
+		if !errors.As(err, &configFileNotFoundError) {
+			return fmt.Errorf("can't read viper config: %w", err)
+		}
+	}
+
+	err = l.setConfigDir()
+	if err != nil {
+		return err
+	}
+
+	var md mapstructure.Metadata
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       decodeHooks(),
+		WeaklyTypedInput: true,
+		Metadata:         &md,
+		Result:           &l.cfg.Output,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = decoder.Decode(l.viper.AllSettings()["output"])
+	if err != nil {
+		return fmt.Errorf("can't unmarshal the output config: %w", err)
+	}
+
+	l.unknownKeys = newUnknownKeys(reflect.TypeOf(l.cfg.Output), "output.", md.Unused)
+
+	return l.handleUnknownKeys(l.opts.StrictConfig)
+}
+
 func (l *Loader) setConfigFile() error {
 	configFile, err := l.evaluateOptions()
 	if err != nil {
@@ -214,11 +270,26 @@ func (l *Loader) parseConfig() error {
 		return err
 	}
 
+	var md mapstructure.Metadata
+
 	// Load configuration from all sources (flags, file).
-	if err := l.viper.Unmarshal(l.cfg, customDecoderHook()); err != nil {
+	if err := l.unmarshal(&md); err != nil {
 		return fmt.Errorf("can't unmarshal config by viper (flags, file): %w", err)
 	}
 
+	l.unknownKeys = newUnknownKeys(reflect.TypeOf(l.cfg), "", md.Unused)
+
+	for i, override := range l.cfg.LintersSettings.Overrides {
+		unused, err := override.unusedKeys(&l.cfg.LintersSettings)
+		if err != nil {
+			// The error is reported by the validation.
+			continue
+		}
+
+		l.unknownKeys = append(l.unknownKeys,
+			newUnknownKeys(reflect.TypeOf(l.cfg.LintersSettings), fmt.Sprintf("linters-settings.overrides[%d].", i), unused)...)
+	}
+
 	if l.cfg.InternalTest { // just for testing purposes: to detect config file usage
 		_, _ = fmt.Fprintln(logutils.StdOut, "test")
 		os.Exit(exitcodes.Success)
@@ -227,6 +298,22 @@ func (l *Loader) parseConfig() error {
 	return nil
 }
 
+// unmarshal is the equivalent of viper.Unmarshal,
+// the metadata are used to collect the keys that are not decoded (i.e. unknown options).
+func (l *Loader) unmarshal(md *mapstructure.Metadata) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       decodeHooks(),
+		WeaklyTypedInput: true,
+		Metadata:         md,
+		Result:           l.cfg,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(l.viper.AllSettings())
+}
+
 func (l *Loader) setConfigDir() error {
 	usedConfigFile := l.viper.ConfigFileUsed()
 	if usedConfigFile == "" {
@@ -256,6 +343,27 @@ func (l *Loader) setConfigDir() error {
 	return nil
 }
 
+func (l *Loader) handleUnknownKeys(strict bool) error {
+	if len(l.unknownKeys) == 0 {
+		return nil
+	}
+
+	if strict {
+		var keys []string
+		for _, k := range l.unknownKeys {
+			keys = append(keys, k.String())
+		}
+
+		return fmt.Errorf("unknown configuration options: %s", strings.Join(keys, ", "))
+	}
+
+	for _, k := range l.unknownKeys {
+		l.log.Warnf("The configuration option %s is unknown and ignored.", k)
+	}
+
+	return nil
+}
+
 // Hack to append values from StringSlice flags.
 // Viper always overrides StringSlice values.
 // https://github.com/spf13/viper/issues/1448
@@ -348,8 +456,8 @@ func (l *Loader) handleDeprecation() error {
 		l.cfg.Output.Formats = f
 	}
 
-	for _, format := range l.cfg.Output.Formats {
-		if format.Format == OutFormatGithubActions {
+	for i := range l.cfg.Output.Formats {
+		if l.cfg.Output.Formats[i].Format == OutFormatGithubActions {
 			l.log.Warnf("The output format `%s` is deprecated, please use `%s`", OutFormatGithubActions, OutFormatColoredLineNumber)
 			break // To avoid repeating the message if there are several usages of github-actions format.
 		}
@@ -464,12 +572,38 @@ func (l *Loader) handleEnableOnlyOption() error {
 }
 
 func customDecoderHook() viper.DecoderConfigOption {
-	return viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+	return viper.DecodeHook(decodeHooks())
+}
+
+func decodeHooks() mapstructure.DecodeHookFunc {
+	return mapstructure.ComposeDecodeHookFunc(
 		// Default hooks (https://github.com/spf13/viper/blob/518241257478c557633ab36e474dfcaeb9a3c623/viper.go#L135-L138).
 		mapstructure.StringToTimeDurationHookFunc(),
 		mapstructure.StringToSliceHookFunc(","),
 
 		// Needed for forbidigo, and output.formats.
 		mapstructure.TextUnmarshallerHookFunc(),
-	))
+
+		// Needed for the expiration date of the rules: YAML decodes unquoted dates as timestamps.
+		timeToStringHookFunc(),
+	)
+}
+
+func timeToStringHookFunc() mapstructure.DecodeHookFuncType {
+	return func(_ reflect.Type, t reflect.Type, data any) (any, error) {
+		if t.Kind() != reflect.String {
+			return data, nil
+		}
+
+		date, ok := data.(time.Time)
+		if !ok {
+			return data, nil
+		}
+
+		if h, m, sec := date.Clock(); h == 0 && m == 0 && sec == 0 {
+			return date.Format(time.DateOnly), nil
+		}
+
+		return date.Format(time.RFC3339), nil
+	}
 }
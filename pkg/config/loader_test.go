@@ -0,0 +1,45 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestLoader_LoadOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".golangci.yml")
+
+	// The other sections are invalid, but they are ignored.
+	content := `
+linters:
+  enable:
+    - unknown
+severity:
+  default-severity: [error]
+output:
+  formats:
+    - format: json
+      path: report.json
+  print-issued-lines: false
+`
+
+	err := os.WriteFile(path, []byte(content), 0o600)
+	require.NoError(t, err)
+
+	cfg := NewDefault()
+
+	loader := NewLoader(logutils.NewStderrLog(logutils.DebugKeyEmpty), viper.New(), nil, LoaderOptions{Config: path}, cfg, nil)
+
+	err = loader.LoadOutput()
+	require.NoError(t, err)
+
+	assert.Equal(t, OutputFormats{{Format: "json", Path: "report.json"}}, cfg.Output.Formats)
+	assert.False(t, cfg.Output.PrintIssuedLine)
+	assert.Empty(t, cfg.Linters.Enable)
+}
@@ -21,6 +21,12 @@ const (
 	OutFormatGithubActions     = "github-actions" // Deprecated
 	OutFormatTeamCity          = "teamcity"
 	OutFormatSarif             = "sarif"
+	OutFormatRDJSON            = "rdjson"
+	OutFormatRDJSONL           = "rdjsonl"
+	OutFormatMarkdown          = "markdown"
+	OutFormatSonarQube         = "sonarqube"
+	OutFormatTemplate          = "template"
+	OutFormatNDJSON            = "ndjson"
 )
 
 var AllOutputFormats = []string{
@@ -37,8 +43,20 @@ var AllOutputFormats = []string{
 	OutFormatGithubActions,
 	OutFormatTeamCity,
 	OutFormatSarif,
+	OutFormatRDJSON,
+	OutFormatRDJSONL,
+	OutFormatMarkdown,
+	OutFormatSonarQube,
+	OutFormatTemplate,
+	OutFormatNDJSON,
 }
 
+// SonarQubeSeverities are the severities of the SonarQube generic issue data.
+var SonarQubeSeverities = []string{"BLOCKER", "CRITICAL", "MAJOR", "MINOR", "INFO"}
+
+// SonarQubeTypes are the types of the SonarQube generic issue data.
+var SonarQubeTypes = []string{"BUG", "VULNERABILITY", "CODE_SMELL"}
+
 type Output struct {
 	Formats         OutputFormats `mapstructure:"formats"`
 	PrintIssuedLine bool          `mapstructure:"print-issued-lines"`
@@ -48,6 +66,9 @@ type Output struct {
 	SortOrder       []string      `mapstructure:"sort-order"`
 	PathPrefix      string        `mapstructure:"path-prefix"`
 	ShowStats       bool          `mapstructure:"show-stats"`
+	ExplainHidden   bool          `mapstructure:"explain-hidden"`
+	Markdown        Markdown      `mapstructure:"markdown"`
+	SonarQube       SonarQube     `mapstructure:"sonarqube"`
 
 	// Deprecated: use Formats instead.
 	Format string `mapstructure:"format"`
@@ -58,33 +79,43 @@ func (o *Output) Validate() error {
 		return errors.New("sort-results should be 'true' to use sort-order")
 	}
 
-	validOrders := []string{"linter", "file", "severity"}
-
-	all := strings.Join(o.SortOrder, " ")
-
-	for _, order := range o.SortOrder {
-		if strings.Count(all, order) > 1 {
-			return fmt.Errorf("the sort-order name %q is repeated several times", order)
-		}
-
-		if !slices.Contains(validOrders, order) {
-			return fmt.Errorf("unsupported sort-order name %q", order)
-		}
+	err := validateSortOrder(o.SortOrder)
+	if err != nil {
+		return err
 	}
 
-	for _, format := range o.Formats {
-		err := format.Validate()
+	for i := range o.Formats {
+		err = o.Formats[i].Validate()
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	err = o.Markdown.Validate()
+	if err != nil {
+		return err
+	}
+
+	return o.SonarQube.Validate()
 }
 
 type OutputFormat struct {
 	Format string `mapstructure:"format"`
 	Path   string `mapstructure:"path"`
+
+	// Template is the path to the text/template file used by the template format.
+	Template string `mapstructure:"template"`
+
+	// The filters of the issues of this output.
+	MinSeverity    string   `mapstructure:"min-severity"`
+	Linters        []string `mapstructure:"linters"`
+	ExcludeLinters []string `mapstructure:"exclude-linters"`
+	OnlyNew        bool     `mapstructure:"only-new"`
+
+	// The options of this output, they override the global options.
+	PrintIssuedLines *bool    `mapstructure:"print-issued-lines"`
+	Colors           *bool    `mapstructure:"colors"`
+	SortOrder        []string `mapstructure:"sort-order"`
 }
 
 func (o *OutputFormat) Validate() error {
@@ -96,22 +127,119 @@ func (o *OutputFormat) Validate() error {
 		return fmt.Errorf("unsupported output format %q", o.Format)
 	}
 
-	return nil
+	if o.Format == OutFormatTemplate && o.Template == "" {
+		return fmt.Errorf("the template is required by the %q format", OutFormatTemplate)
+	}
+
+	if o.Format != OutFormatTemplate && o.Template != "" {
+		return fmt.Errorf("the template can only be used with the %q format", OutFormatTemplate)
+	}
+
+	if o.MinSeverity != "" && SeverityRank(o.MinSeverity) == 0 {
+		return fmt.Errorf("unsupported min-severity %q", o.MinSeverity)
+	}
+
+	for _, name := range o.Linters {
+		if slices.Contains(o.ExcludeLinters, name) {
+			return fmt.Errorf("the linter %q can't be included and excluded", name)
+		}
+	}
+
+	return validateSortOrder(o.SortOrder)
 }
 
 type OutputFormats []OutputFormat
 
+// HasOnlyNew returns true if an output only shows the new issues.
+func (p OutputFormats) HasOnlyNew() bool {
+	return slices.ContainsFunc(p, func(format OutputFormat) bool { return format.OnlyNew })
+}
+
 func (p *OutputFormats) UnmarshalText(text []byte) error {
 	formats := strings.Split(string(text), ",")
 
 	for _, item := range formats {
 		format, path, _ := strings.Cut(item, ":")
 
+		// The template format is followed by the path to the template, then by the output path: `template:tpl[:path]`.
+		var tmpl string
+		if format == OutFormatTemplate {
+			tmpl, path, _ = strings.Cut(path, ":")
+		}
+
 		*p = append(*p, OutputFormat{
-			Path:   path,
-			Format: format,
+			Path:     path,
+			Format:   format,
+			Template: tmpl,
 		})
 	}
 
 	return nil
 }
+
+func validateSortOrder(orders []string) error {
+	validOrders := []string{"linter", "file", "severity"}
+
+	all := strings.Join(orders, " ")
+
+	for _, order := range orders {
+		if strings.Count(all, order) > 1 {
+			return fmt.Errorf("the sort-order name %q is repeated several times", order)
+		}
+
+		if !slices.Contains(validOrders, order) {
+			return fmt.Errorf("unsupported sort-order name %q", order)
+		}
+	}
+
+	return nil
+}
+
+// Markdown is the configuration of the markdown format.
+type Markdown struct {
+	// MaxSize is the maximum size of the output in bytes (0 means the default size).
+	MaxSize int `mapstructure:"max-size"`
+}
+
+func (m *Markdown) Validate() error {
+	if m.MaxSize < 0 {
+		return fmt.Errorf("the markdown max-size can't be negative: %d", m.MaxSize)
+	}
+
+	return nil
+}
+
+// SonarQube is the configuration of the SonarQube generic issue data.
+type SonarQube struct {
+	// Severities maps the severities of the issues to the SonarQube severities.
+	Severities map[string]string `mapstructure:"severities"`
+	// Types maps the linters to the SonarQube types.
+	Types map[string]string `mapstructure:"types"`
+
+	DefaultSeverity string `mapstructure:"default-severity"`
+	DefaultType     string `mapstructure:"default-type"`
+}
+
+func (s *SonarQube) Validate() error {
+	for severity, value := range s.Severities {
+		if !slices.Contains(SonarQubeSeverities, value) {
+			return fmt.Errorf("unsupported SonarQube severity %q for the severity %q", value, severity)
+		}
+	}
+
+	if s.DefaultSeverity != "" && !slices.Contains(SonarQubeSeverities, s.DefaultSeverity) {
+		return fmt.Errorf("unsupported SonarQube default severity %q", s.DefaultSeverity)
+	}
+
+	for linter, value := range s.Types {
+		if !slices.Contains(SonarQubeTypes, value) {
+			return fmt.Errorf("unsupported SonarQube type %q for the linter %q", value, linter)
+		}
+	}
+
+	if s.DefaultType != "" && !slices.Contains(SonarQubeTypes, s.DefaultType) {
+		return fmt.Errorf("unsupported SonarQube default type %q", s.DefaultType)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,6 +40,23 @@ func TestOutput_Validate(t *testing.T) {
 				SortOrder:   []string{"file", "linter", "severity"},
 			},
 		},
+		{
+			desc: "markdown",
+			settings: &Output{
+				Markdown: Markdown{MaxSize: 1 << 20},
+			},
+		},
+		{
+			desc: "sonarqube",
+			settings: &Output{
+				SonarQube: SonarQube{
+					Severities:      map[string]string{"error": "BLOCKER"},
+					Types:           map[string]string{"gosec": "VULNERABILITY"},
+					DefaultSeverity: "MINOR",
+					DefaultType:     "BUG",
+				},
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -91,6 +109,45 @@ func TestOutput_Validate_error(t *testing.T) {
 			},
 			expected: `unsupported output format "test"`,
 		},
+		{
+			desc: "negative markdown max-size",
+			settings: &Output{
+				Markdown: Markdown{MaxSize: -1},
+			},
+			expected: "the markdown max-size can't be negative: -1",
+		},
+		{
+			desc: "invalid sonarqube severity",
+			settings: &Output{
+				SonarQube: SonarQube{
+					Severities: map[string]string{"error": "high"},
+				},
+			},
+			expected: `unsupported SonarQube severity "high" for the severity "error"`,
+		},
+		{
+			desc: "invalid sonarqube default severity",
+			settings: &Output{
+				SonarQube: SonarQube{DefaultSeverity: "high"},
+			},
+			expected: `unsupported SonarQube default severity "high"`,
+		},
+		{
+			desc: "invalid sonarqube type",
+			settings: &Output{
+				SonarQube: SonarQube{
+					Types: map[string]string{"gosec": "SECURITY"},
+				},
+			},
+			expected: `unsupported SonarQube type "SECURITY" for the linter "gosec"`,
+		},
+		{
+			desc: "invalid sonarqube default type",
+			settings: &Output{
+				SonarQube: SonarQube{DefaultType: "SECURITY"},
+			},
+			expected: `unsupported SonarQube default type "SECURITY"`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -104,6 +161,8 @@ func TestOutput_Validate_error(t *testing.T) {
 }
 
 func TestOutputFormat_Validate(t *testing.T) {
+	disabled, enabled := false, true
+
 	testCases := []struct {
 		desc     string
 		settings *OutputFormat
@@ -128,6 +187,26 @@ func TestOutputFormat_Validate(t *testing.T) {
 				Path:   "/tmp/example.json",
 			},
 		},
+		{
+			desc: "template",
+			settings: &OutputFormat{
+				Format:   "template",
+				Template: "./example.tmpl",
+			},
+		},
+		{
+			desc: "filters and options",
+			settings: &OutputFormat{
+				Format:           "sarif",
+				MinSeverity:      "Warning",
+				Linters:          []string{"gosec", "govet"},
+				ExcludeLinters:   []string{"lll"},
+				OnlyNew:          true,
+				PrintIssuedLines: &disabled,
+				Colors:           &enabled,
+				SortOrder:        []string{"severity", "file"},
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -158,6 +237,46 @@ func TestOutputFormat_Validate_error(t *testing.T) {
 			},
 			expected: `unsupported output format "test"`,
 		},
+		{
+			desc: "template without template path",
+			settings: &OutputFormat{
+				Format: "template",
+			},
+			expected: `the template is required by the "template" format`,
+		},
+		{
+			desc: "template path with another format",
+			settings: &OutputFormat{
+				Format:   "json",
+				Template: "./example.tmpl",
+			},
+			expected: `the template can only be used with the "template" format`,
+		},
+		{
+			desc: "invalid min-severity",
+			settings: &OutputFormat{
+				Format:      "json",
+				MinSeverity: "foo",
+			},
+			expected: `unsupported min-severity "foo"`,
+		},
+		{
+			desc: "linter included and excluded",
+			settings: &OutputFormat{
+				Format:         "json",
+				Linters:        []string{"gosec", "govet"},
+				ExcludeLinters: []string{"govet"},
+			},
+			expected: `the linter "govet" can't be included and excluded`,
+		},
+		{
+			desc: "invalid sort-order",
+			settings: &OutputFormat{
+				Format:    "json",
+				SortOrder: []string{"a"},
+			},
+			expected: `unsupported sort-order name "a"`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -169,3 +288,19 @@ func TestOutputFormat_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestOutputFormats_UnmarshalText(t *testing.T) {
+	var formats OutputFormats
+
+	err := formats.UnmarshalText([]byte("json,checkstyle:report.xml,template:example.tmpl,template:example.tmpl:report.txt"))
+	require.NoError(t, err)
+
+	expected := OutputFormats{
+		{Format: "json"},
+		{Format: "checkstyle", Path: "report.xml"},
+		{Format: "template", Template: "example.tmpl"},
+		{Format: "template", Template: "example.tmpl", Path: "report.txt"},
+	}
+
+	assert.Equal(t, expected, formats)
+}
@@ -3,10 +3,31 @@ package config
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 const severityRuleMinConditionsCount = 1
 
+// severityRanks orders the common severities (linters, output formats).
+var severityRanks = map[string]int{
+	"info":     1,
+	"low":      1,
+	"minor":    1,
+	"warning":  2,
+	"medium":   2,
+	"major":    2,
+	"error":    3,
+	"high":     3,
+	"critical": 4,
+	"blocker":  5,
+}
+
+// SeverityRank returns the rank of a common severity (the highest is the most important),
+// or 0 if the severity is unknown.
+func SeverityRank(severity string) int {
+	return severityRanks[strings.ToLower(severity)]
+}
+
 type Severity struct {
 	Default       string         `mapstructure:"default-severity"`
 	CaseSensitive bool           `mapstructure:"case-sensitive"`
@@ -18,8 +39,8 @@ func (s *Severity) Validate() error {
 		return errors.New("can't set severity rule option: no default severity defined")
 	}
 
-	for i, rule := range s.Rules {
-		if err := rule.Validate(); err != nil {
+	for i := range s.Rules {
+		if err := s.Rules[i].Validate(); err != nil {
 			return fmt.Errorf("error in severity rule #%d: %w", i, err)
 		}
 	}
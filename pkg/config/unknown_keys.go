@@ -0,0 +1,188 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// unknownKey is an option of the configuration file that doesn't match any field of the configuration.
+type unknownKey struct {
+	key        string
+	suggestion string
+}
+
+func newUnknownKeys(typ reflect.Type, prefix string, keys []string) []unknownKey {
+	slices.Sort(keys)
+
+	var unknownKeys []unknownKey
+
+	for _, key := range keys {
+		key = strings.ToLower(key)
+
+		unknownKeys = append(unknownKeys, unknownKey{
+			key:        prefix + key,
+			suggestion: suggestKey(typ, key),
+		})
+	}
+
+	return unknownKeys
+}
+
+func (k unknownKey) String() string {
+	if k.suggestion == "" {
+		return fmt.Sprintf("%q", k.key)
+	}
+
+	return fmt.Sprintf("%q (did you mean %q?)", k.key, k.suggestion)
+}
+
+// suggestKey returns the name of the option closest to the last element of the key,
+// among the options at the same level, or an empty string if there is no close option.
+// The key is a path as reported by mapstructure (ex: `linters-settings.goconst.min-ln`, `issues.exclude-rules[0].linter`).
+func suggestKey(typ reflect.Type, key string) string {
+	parts := strings.Split(key, ".")
+
+	for _, part := range parts[:len(parts)-1] {
+		name, _, _ := strings.Cut(part, "[")
+
+		field, ok := findOption(typ, name)
+		if !ok {
+			return ""
+		}
+
+		typ = field.Type
+	}
+
+	return closestName(parts[len(parts)-1], optionNames(typ))
+}
+
+func findOption(typ reflect.Type, name string) (reflect.StructField, bool) {
+	typ = indirectType(typ)
+	if typ.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		optName, squash := parseOptionTag(&field)
+
+		switch {
+		case squash:
+			if f, ok := findOption(field.Type, name); ok {
+				return f, true
+			}
+
+		case strings.EqualFold(optName, name):
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func optionNames(typ reflect.Type) []string {
+	typ = indirectType(typ)
+	if typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, squash := parseOptionTag(&field)
+
+		switch {
+		case squash:
+			names = append(names, optionNames(field.Type)...)
+
+		case name != "" && name != "-":
+			names = append(names, strings.ToLower(name))
+		}
+	}
+
+	return names
+}
+
+// parseOptionTag returns the name of the option associated to the field, and true if the field is squashed.
+func parseOptionTag(field *reflect.StructField) (string, bool) {
+	name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+
+	switch opts {
+	case "squash":
+		return "", true
+	case "remain":
+		return "", false
+	}
+
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, false
+}
+
+// indirectType returns the type of the elements of pointers, slices, and maps.
+func indirectType(typ reflect.Type) reflect.Type {
+	for {
+		switch typ.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			typ = typ.Elem()
+		default:
+			return typ
+		}
+	}
+}
+
+func closestName(name string, candidates []string) string {
+	// Allows roughly one typo every 3 characters.
+	maxDistance := max(2, len(name)/3)
+
+	var closest string
+
+	for _, candidate := range candidates {
+		distance := levenshtein(name, candidate)
+		if distance <= maxDistance {
+			maxDistance = distance - 1
+			closest = candidate
+		}
+	}
+
+	return closest
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_suggestKey(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		key      string
+		expected string
+	}{
+		{
+			desc:     "linter settings",
+			key:      "linters-settings.goconst.min-ln",
+			expected: "min-len",
+		},
+		{
+			desc:     "untagged field",
+			key:      "linters-settings.funlen.line",
+			expected: "lines",
+		},
+		{
+			desc:     "squashed field",
+			key:      "issues.exclude-rules[0].txt",
+			expected: "text",
+		},
+		{
+			desc:     "map of structs",
+			key:      "linters-settings.custom[example].pth",
+			expected: "path",
+		},
+		{
+			desc:     "root",
+			key:      "linter",
+			expected: "linters",
+		},
+		{
+			desc: "no close option",
+			key:  "linters-settings.goconst.foo",
+		},
+		{
+			desc: "unknown parent",
+			key:  "linters-settings.foo.bar",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			suggestion := suggestKey(reflect.TypeOf(Config{}), test.key)
+
+			assert.Equal(t, test.expected, suggestion)
+		})
+	}
+}
+
+func Test_newUnknownKeys(t *testing.T) {
+	keys := newUnknownKeys(reflect.TypeOf(LintersSettings{}), "linters-settings.overrides[0].",
+		[]string{"lll.line-lengthh", "funlen.foo"})
+
+	expected := []unknownKey{
+		{key: "linters-settings.overrides[0].funlen.foo"},
+		{key: "linters-settings.overrides[0].lll.line-lengthh", suggestion: "line-length"},
+	}
+
+	assert.Equal(t, expected, keys)
+
+	assert.Equal(t, `"linters-settings.overrides[0].funlen.foo"`, keys[0].String())
+	assert.Equal(t, `"linters-settings.overrides[0].lll.line-lengthh" (did you mean "line-length"?)`, keys[1].String())
+}
@@ -23,10 +23,12 @@ func NewIssue(issue *result.Issue, pass *analysis.Pass) Issue {
 type EncodingIssue struct {
 	FromLinter           string
 	Text                 string
+	RuleID               string
 	Severity             string
 	Pos                  token.Position
 	LineRange            *result.Range
 	Replacement          *result.Replacement
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
+	ExpectedNoLintRule   string
 }
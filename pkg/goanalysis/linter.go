@@ -49,6 +49,7 @@ type Linter struct {
 	contextSetter           func(*linter.Context)
 	loadMode                LoadMode
 	needUseOriginalPackages bool
+	scope                   string
 }
 
 func NewLinter(name, desc string, analyzers []*analysis.Analyzer, cfg map[string]map[string]any) *Linter {
@@ -172,6 +173,10 @@ func (lnt *Linter) getAnalyzers() []*analysis.Analyzer {
 	return lnt.analyzers
 }
 
+func (lnt *Linter) getScope() string {
+	return lnt.scope
+}
+
 func (lnt *Linter) useOriginalPackages() bool {
 	return lnt.needUseOriginalPackages
 }
@@ -0,0 +1,131 @@
+package goanalysis
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// LinterScope is an instance of a linter, with its own settings, applied to the files matching the path.
+type LinterScope struct {
+	ID     string
+	Path   *regexp.Regexp
+	Linter *Linter
+}
+
+// ScopedLinter runs several instances of the same linter with different settings.
+// An issue is kept only if it's reported by the instance of the first scope matching its file,
+// or by the base instance if no scope matches.
+type ScopedLinter struct {
+	base   *Linter
+	scopes []LinterScope
+}
+
+func NewScopedLinter(base *Linter, scopes []LinterScope) *ScopedLinter {
+	for _, scope := range scopes {
+		scope.Linter.scope = scope.ID
+	}
+
+	return &ScopedLinter{base: base, scopes: scopes}
+}
+
+func (sl *ScopedLinter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var retIssues []result.Issue
+
+	for i := -1; i < len(sl.scopes); i++ {
+		scopeCtx := sl.scopeContext(lintCtx, i)
+		if len(scopeCtx.Packages) == 0 && len(scopeCtx.OriginalPackages) == 0 {
+			continue
+		}
+
+		lnt := sl.base
+		if i >= 0 {
+			lnt = sl.scopes[i].Linter
+		}
+
+		issues, err := lnt.Run(ctx, scopeCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		retIssues = append(retIssues, sl.filterIssues(issues, i)...)
+	}
+
+	return retIssues, nil
+}
+
+func (sl *ScopedLinter) Name() string {
+	return sl.base.Name()
+}
+
+func (sl *ScopedLinter) Desc() string {
+	return sl.base.Desc()
+}
+
+func (sl *ScopedLinter) filterIssues(issues []result.Issue, scopeIndex int) []result.Issue {
+	var ret []result.Issue
+
+	for i := range issues {
+		if sl.findScope(issues[i].FilePath()) == scopeIndex {
+			ret = append(ret, issues[i])
+		}
+	}
+
+	return ret
+}
+
+// scopeContext returns a copy of the context restricted to the packages containing files of the scope,
+// so each instance only analyzes the packages where its issues can be kept.
+func (sl *ScopedLinter) scopeContext(lintCtx *linter.Context, scopeIndex int) *linter.Context {
+	scopeCtx := *lintCtx
+	scopeCtx.Packages = sl.filterPackages(lintCtx.Packages, scopeIndex)
+	scopeCtx.OriginalPackages = sl.filterPackages(lintCtx.OriginalPackages, scopeIndex)
+
+	return &scopeCtx
+}
+
+func (sl *ScopedLinter) filterPackages(pkgs []*packages.Package, scopeIndex int) []*packages.Package {
+	var ret []*packages.Package
+
+	inScope := func(filename string) bool {
+		return sl.findScope(filename) == scopeIndex
+	}
+
+	for _, pkg := range pkgs {
+		if slices.ContainsFunc(pkg.GoFiles, inScope) || slices.ContainsFunc(pkg.CompiledGoFiles, inScope) {
+			ret = append(ret, pkg)
+		}
+	}
+
+	return ret
+}
+
+// findScope returns the index of the first scope matching the file, or -1.
+func (sl *ScopedLinter) findScope(filename string) int {
+	if filename == "" {
+		return -1
+	}
+
+	if filepath.IsAbs(filename) {
+		if rel, err := fsutils.ShortestRelPath(filename, ""); err == nil {
+			filename = rel
+		}
+	}
+
+	filename = filepath.ToSlash(filename)
+
+	for i, scope := range sl.scopes {
+		if scope.Path.MatchString(filename) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,48 @@
+package goanalysis
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+func TestScopedLinter_scopeContext(t *testing.T) {
+	sl := NewScopedLinter(&Linter{}, []LinterScope{
+		{ID: "tests", Path: regexp.MustCompile(`_test\.go$`), Linter: &Linter{}},
+		{ID: "gen", Path: regexp.MustCompile(`^gen/`), Linter: &Linter{}},
+	})
+
+	pkgA := &packages.Package{ID: "a", GoFiles: []string{"a/a.go", "a/a_test.go"}}
+	pkgB := &packages.Package{ID: "b", GoFiles: []string{"b/b.go"}}
+	pkgGen := &packages.Package{ID: "gen", GoFiles: []string{"gen/gen.go"}}
+
+	lintCtx := &linter.Context{
+		Packages:         []*packages.Package{pkgA, pkgB, pkgGen},
+		OriginalPackages: []*packages.Package{pkgA, pkgB, pkgGen},
+	}
+
+	testCases := []struct {
+		desc       string
+		scopeIndex int
+		expected   []*packages.Package
+	}{
+		{desc: "base", scopeIndex: -1, expected: []*packages.Package{pkgA, pkgB}},
+		{desc: "tests", scopeIndex: 0, expected: []*packages.Package{pkgA}},
+		{desc: "gen", scopeIndex: 1, expected: []*packages.Package{pkgGen}},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			scopeCtx := sl.scopeContext(lintCtx, test.scopeIndex)
+
+			assert.Equal(t, test.expected, scopeCtx.Packages)
+			assert.Equal(t, test.expected, scopeCtx.OriginalPackages)
+		})
+	}
+}
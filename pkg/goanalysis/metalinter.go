@@ -61,6 +61,10 @@ func (MetaLinter) getName() string {
 	return "metalinter"
 }
 
+func (MetaLinter) getScope() string {
+	return ""
+}
+
 func (MetaLinter) useOriginalPackages() bool {
 	return false // `unused` can't be run by this metalinter
 }
@@ -20,6 +20,7 @@ type runAnalyzersConfig interface {
 	useOriginalPackages() bool
 	reportIssues(*linter.Context) []Issue
 	getLoadMode() LoadMode
+	getScope() string
 }
 
 func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Issue, error) {
@@ -36,7 +37,9 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 		pkgs = lintCtx.OriginalPackages
 	}
 
-	issues, pkgsFromCache := loadIssuesFromCache(pkgs, lintCtx, cfg.getAnalyzers())
+	lintResKey := getIssuesCacheKey(cfg.getAnalyzers(), cfg.getScope())
+
+	issues, pkgsFromCache := loadIssuesFromCache(pkgs, lintCtx, lintResKey)
 	var pkgsToAnalyze []*packages.Package
 	for _, pkg := range pkgs {
 		if !pkgsFromCache[pkg] {
@@ -50,7 +53,7 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 		if len(errs) == 0 {
 			// If we try to save to cache even if we have compilation errors
 			// we won't see them on repeated runs.
-			saveIssuesToCache(pkgs, pkgsFromCache, issues, lintCtx, cfg.getAnalyzers())
+			saveIssuesToCache(pkgs, pkgsFromCache, issues, lintCtx, lintResKey)
 		}
 	}()
 
@@ -85,16 +88,18 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 		diag := &diags[i]
 		linterName := linterNameBuilder(diag)
 
-		var text string
+		var text, ruleID string
 		if diag.Analyzer.Name == linterName {
 			text = diag.Message
 		} else {
 			text = fmt.Sprintf("%s: %s", diag.Analyzer.Name, diag.Message)
+			ruleID = diag.Analyzer.Name
 		}
 
 		issues = append(issues, result.Issue{
 			FromLinter: linterName,
 			Text:       text,
+			RuleID:     ruleID,
 			Pos:        diag.Position,
 			Pkg:        diag.Pkg,
 		})
@@ -104,6 +109,7 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 				issues = append(issues, result.Issue{
 					FromLinter: linterName,
 					Text:       fmt.Sprintf("%s(related information): %s", diag.Analyzer.Name, info.Message),
+					RuleID:     ruleID,
 					Pos:        diag.Pkg.Fset.Position(info.Pos),
 					Pkg:        diag.Pkg,
 				})
@@ -17,7 +17,7 @@ import (
 )
 
 func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.Package]bool,
-	issues []result.Issue, lintCtx *linter.Context, analyzers []*analysis.Analyzer,
+	issues []result.Issue, lintCtx *linter.Context, lintResKey string,
 ) {
 	startedAt := time.Now()
 	perPkgIssues := map[*packages.Package][]result.Issue{}
@@ -27,7 +27,6 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 	}
 
 	var savedIssuesCount int64 = 0
-	lintResKey := getIssuesCacheKey(analyzers)
 
 	workerCount := runtime.GOMAXPROCS(-1)
 	var wg sync.WaitGroup
@@ -45,12 +44,14 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 					encodedIssues = append(encodedIssues, EncodingIssue{
 						FromLinter:           i.FromLinter,
 						Text:                 i.Text,
+						RuleID:               i.RuleID,
 						Severity:             i.Severity,
 						Pos:                  i.Pos,
 						LineRange:            i.LineRange,
 						Replacement:          i.Replacement,
 						ExpectNoLint:         i.ExpectNoLint,
 						ExpectedNoLintLinter: i.ExpectedNoLintLinter,
+						ExpectedNoLintRule:   i.ExpectedNoLintRule,
 					})
 				}
 
@@ -80,11 +81,10 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 }
 
 func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
-	analyzers []*analysis.Analyzer,
+	lintResKey string,
 ) (issuesFromCache []result.Issue, pkgsFromCache map[*packages.Package]bool) {
 	startedAt := time.Now()
 
-	lintResKey := getIssuesCacheKey(analyzers)
 	type cacheRes struct {
 		issues  []result.Issue
 		loadErr error
@@ -120,6 +120,7 @@ func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
 					issues = append(issues, result.Issue{
 						FromLinter:           issue.FromLinter,
 						Text:                 issue.Text,
+						RuleID:               issue.RuleID,
 						Severity:             issue.Severity,
 						Pos:                  issue.Pos,
 						LineRange:            issue.LineRange,
@@ -127,6 +128,7 @@ func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
 						Pkg:                  pkg,
 						ExpectNoLint:         issue.ExpectNoLint,
 						ExpectedNoLintLinter: issue.ExpectedNoLintLinter,
+						ExpectedNoLintRule:   issue.ExpectedNoLintRule,
 					})
 				}
 				cacheRes.issues = issues
@@ -157,8 +159,14 @@ func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
 	return issuesFromCache, pkgsFromCache
 }
 
-func getIssuesCacheKey(analyzers []*analysis.Analyzer) string {
-	return "lint/result:" + analyzersHashID(analyzers)
+func getIssuesCacheKey(analyzers []*analysis.Analyzer, scope string) string {
+	key := "lint/result:" + analyzersHashID(analyzers)
+	if scope != "" {
+		// The instances of a scoped linter have the same analyzers but not the same settings.
+		key += "@" + scope
+	}
+
+	return key
 }
 
 func analyzersHashID(analyzers []*analysis.Analyzer) string {
@@ -0,0 +1,20 @@
+//golangcitest:args -Efunlen
+//golangcitest:config_path testdata/funlen_overrides.yml
+package testdata
+
+func TooManyLinesOverridden() { // want `Function 'TooManyLinesOverridden' is too long \(8 > 5\)`
+	a := 1
+	b := a
+	c := b
+	d := c
+	e := d
+	f := e
+	g := f
+	_ = g
+}
+
+func NotTooManyLines() {
+	a := 1
+	b := a
+	_ = b
+}
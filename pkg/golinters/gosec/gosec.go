@@ -138,6 +138,7 @@ func runGoSec(lintCtx *linter.Context, pass *analysis.Pass, settings *config.GoS
 				Column:   column,
 			},
 			Text:       text,
+			RuleID:     i.RuleID,
 			LineRange:  r,
 			FromLinter: linterName,
 		}, pass))
@@ -24,6 +24,16 @@ func GosecNolintGosec() {
 	log.Print(h)
 }
 
+func GosecNolintRule() {
+	h := md5.New() //nolint:gosec(G401)
+	log.Print(h)
+}
+
+func GosecNolintOtherRule() {
+	h := md5.New() //nolint:gosec(G304) // want "G401: Use of weak cryptographic primitive"
+	log.Print(h)
+}
+
 func GosecNoErrorCheckingByDefault() {
 	f, _ := os.Create("foo")
 	fmt.Println(f)
@@ -7,6 +7,7 @@ import (
 	"go/token"
 	"regexp"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -94,12 +95,16 @@ func (i NoExplanation) String() string { return toString(i) }
 type UnusedCandidate struct {
 	BaseIssue
 	ExpectedLinter string
+	ExpectedRule   string
 }
 
 //nolint:gocritic // TODO(ldez) must be change in the future.
 func (i UnusedCandidate) Details() string {
 	details := fmt.Sprintf("directive `%s` is unused", i.fullDirective)
-	if i.ExpectedLinter != "" {
+	switch {
+	case i.ExpectedRule != "":
+		details += fmt.Sprintf(" for rule %q of linter %q", i.ExpectedRule, i.ExpectedLinter)
+	case i.ExpectedLinter != "":
 		details += fmt.Sprintf(" for linter %q", i.ExpectedLinter)
 	}
 	return details
@@ -107,6 +112,75 @@ func (i UnusedCandidate) Details() string {
 
 func (i UnusedCandidate) String() string { return toString(i) }
 
+type ExplanationMismatch struct {
+	BaseIssue
+	pattern string
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i ExplanationMismatch) Details() string {
+	return fmt.Sprintf("directive `%s` should provide explanation matching `%s`", i.fullDirective, i.pattern)
+}
+
+func (i ExplanationMismatch) String() string { return toString(i) }
+
+type NoExpiration struct {
+	BaseIssue
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i NoExpiration) Details() string {
+	return fmt.Sprintf("directive `%s` should provide expiration date such as `until=YYYY-MM-DD` in its explanation",
+		i.fullDirective)
+}
+
+func (i NoExpiration) String() string { return toString(i) }
+
+type InvalidExpiration struct {
+	BaseIssue
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i InvalidExpiration) Details() string {
+	return fmt.Sprintf("directive `%s` has an invalid expiration date (expected format `until=YYYY-MM-DD`)", i.fullDirective)
+}
+
+func (i InvalidExpiration) String() string { return toString(i) }
+
+type Expired struct {
+	BaseIssue
+	date string
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i Expired) Details() string {
+	return fmt.Sprintf("directive `%s` expired on %s, it's no longer applied", i.fullDirective, i.date)
+}
+
+func (i Expired) String() string { return toString(i) }
+
+type UnterminatedRegion struct {
+	BaseIssue
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i UnterminatedRegion) Details() string {
+	return fmt.Sprintf("directive `%s` is not terminated by `//golangci:enable`", i.fullDirective)
+}
+
+func (i UnterminatedRegion) String() string { return toString(i) }
+
+type UnexpectedRegionEnd struct {
+	BaseIssue
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i UnexpectedRegionEnd) Details() string {
+	return fmt.Sprintf("directive `%s` doesn't terminate any `//golangci:disable` directive", i.fullDirective)
+}
+
+func (i UnexpectedRegionEnd) String() string { return toString(i) }
+
 func toString(issue Issue) string {
 	return fmt.Sprintf("%s at %s", issue.Details(), issue.Position())
 }
@@ -125,29 +199,104 @@ const (
 	NeedsSpecific
 	NeedsExplanation
 	NeedsUnused
+	NeedsExpiration
 	NeedsAll = NeedsMachineOnly | NeedsSpecific | NeedsExplanation
 )
 
+// ExpirationLayout is the layout of the expiration date of a directive (`until=YYYY-MM-DD`).
+const ExpirationLayout = time.DateOnly
+
+// matches the expiration date inside the explanation of a directive
+var expirationPattern = regexp.MustCompile(`(?:^|[\s/])until=(\S*)`)
+
+// ParseExpiration returns the expiration date of a directive, from its explanation (ex: `// JIRA-123 until=2026-12-01`).
+// The directive is applied until the end of the expiration day.
+// It returns false if the explanation doesn't contain an expiration date.
+func ParseExpiration(explanation string) (time.Time, bool, error) {
+	matches := expirationPattern.FindStringSubmatch(explanation)
+	if len(matches) == 0 {
+		return time.Time{}, false, nil
+	}
+
+	date, err := time.ParseInLocation(ExpirationLayout, matches[1], time.Local)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return date.AddDate(0, 0, 1), true, nil
+}
+
 var commentPattern = regexp.MustCompile(`^//\s*(nolint)(:\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*)?\b`)
 
-// matches a complete nolint directive
-var fullDirectivePattern = regexp.MustCompile(`^//\s*nolint(?::(\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*))?\s*(//.*)?\s*\n?$`)
+// matches a complete nolint directive, a linter can be restricted to a rule (ex: `gosec(G304)`)
+var fullDirectivePattern = regexp.MustCompile(
+	`^//\s*nolint(?::(\s*[\w-]+(?:\([\w.-]+\))?\s*(?:,\s*[\w-]+(?:\([\w.-]+\))?\s*)*))?\s*(//.*)?\s*\n?$`)
+
+// matches a region directive: `//golangci:disable [linters]` or `//golangci:enable`
+var regionPattern = regexp.MustCompile(`^//golangci:(disable|enable)(?:\s+([^/]*))?(//.*)?$`)
+
+// RegionDirective is a `//golangci:disable [linters]` or `//golangci:enable` directive.
+type RegionDirective struct {
+	// Enable is true for `//golangci:enable`, and false for `//golangci:disable`.
+	Enable bool
+	// Linters is the comma-separated list of linters (ex: `gosec(G304),staticcheck`), it can be empty.
+	Linters string
+	// Explanation is the comment after the directive (ex: `// JIRA-123`).
+	Explanation string
+}
+
+// ParseRegionDirective parses a `//golangci:disable [linters]` or `//golangci:enable` directive.
+// It returns false if the comment is not a region directive.
+func ParseRegionDirective(text string) (RegionDirective, bool) {
+	matches := regionPattern.FindStringSubmatch(text)
+	if len(matches) == 0 {
+		return RegionDirective{}, false
+	}
+
+	return RegionDirective{
+		Enable:      matches[1] == "enable",
+		Linters:     matches[2],
+		Explanation: matches[3],
+	}, true
+}
+
+// Prefix returns the directive without the linters and the explanation (ex: `//golangci:disable`).
+func (d RegionDirective) Prefix() string {
+	if d.Enable {
+		return "//golangci:enable"
+	}
+
+	return "//golangci:disable"
+}
 
 type Linter struct {
-	needs           Needs // indicates which linter checks to perform
-	excludeByLinter map[string]bool
+	needs              Needs // indicates which linter checks to perform
+	excludeByLinter    map[string]bool
+	explanationPattern *regexp.Regexp
+	now                time.Time
 }
 
 // NewLinter creates a linter that enforces that the provided directives fulfill the provided requirements
-func NewLinter(needs Needs, excludes []string) (*Linter, error) {
+func NewLinter(needs Needs, excludes []string, explanationPattern string) (*Linter, error) {
 	excludeByName := make(map[string]bool)
 	for _, e := range excludes {
 		excludeByName[e] = true
 	}
 
+	var pattern *regexp.Regexp
+	if explanationPattern != "" {
+		var err error
+		pattern, err = regexp.Compile(explanationPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid explanation pattern: %w", err)
+		}
+	}
+
 	return &Linter{
-		needs:           needs | NeedsMachineOnly,
-		excludeByLinter: excludeByName,
+		needs:              needs | NeedsMachineOnly,
+		excludeByLinter:    excludeByName,
+		explanationPattern: pattern,
+		now:                time.Now(),
 	}, nil
 }
 
@@ -166,6 +315,8 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 			continue
 		}
 
+		issues = append(issues, l.runRegions(fset, file)...)
+
 		for _, c := range file.Comments {
 			for _, comment := range c.List {
 				if !commentPattern.MatchString(comment.Text) {
@@ -226,7 +377,7 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 
 				lintersText, explanation := fullMatches[1], fullMatches[2]
 
-				var linters []string
+				var linters, rules []string
 				if lintersText != "" && !strings.HasPrefix(lintersText, "all") {
 					lls := strings.Split(lintersText, ",")
 					linters = make([]string, 0, len(lls))
@@ -236,9 +387,10 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 						if i < len(lls)-1 {
 							rangeEnd++ // include trailing comma
 						}
-						trimmedLinterName := strings.TrimSpace(ll)
+						trimmedLinterName, rule, _ := strings.Cut(strings.TrimSpace(ll), "(")
 						if trimmedLinterName != "" {
 							linters = append(linters, trimmedLinterName)
+							rules = append(rules, strings.TrimSuffix(rule, ")"))
 						}
 						rangeStart = rangeEnd
 					}
@@ -250,8 +402,9 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 					}
 				}
 
-				// when detecting unused directives, we send all the directives through and filter them out in the nolint processor
-				if (l.needs & NeedsUnused) != 0 {
+				// when detecting unused directives, we send all the directives through and filter them out in the nolint processor.
+				// The expired directives are not applied, they are only reported as expired.
+				if (l.needs&NeedsUnused) != 0 && !l.isExpired(explanation) {
 					removeNolintCompletely := &result.Replacement{}
 
 					startCol := pos.Column - 1
@@ -272,8 +425,8 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 						issue.replacement = removeNolintCompletely
 						issues = append(issues, issue)
 					} else {
-						for _, linter := range linters {
-							issue := UnusedCandidate{BaseIssue: base, ExpectedLinter: linter}
+						for i, linter := range linters {
+							issue := UnusedCandidate{BaseIssue: base, ExpectedLinter: linter, ExpectedRule: rules[i]}
 							// only offer replacement if there is a single linter
 							// because of issues around commas and the possibility of all
 							// linters being removed
@@ -286,16 +439,7 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 				}
 
 				if (l.needs&NeedsExplanation) != 0 && (explanation == "" || strings.TrimSpace(explanation) == "//") {
-					needsExplanation := len(linters) == 0 // if no linters are mentioned, we must have explanation
-					// otherwise, check if we are excluding all the mentioned linters
-					for _, ll := range linters {
-						if !l.excludeByLinter[ll] { // if a linter does require explanation
-							needsExplanation = true
-							break
-						}
-					}
-
-					if needsExplanation {
+					if l.needsExplanation(linters) {
 						fullDirectiveWithoutExplanation := trailingBlankExplanation.ReplaceAllString(comment.Text, "")
 						issues = append(issues, NoExplanation{
 							BaseIssue:                       base,
@@ -303,9 +447,130 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 						})
 					}
 				}
+
+				issues = append(issues, l.checkExplanationContent(&base, explanation, linters)...)
 			}
 		}
 	}
 
 	return issues, nil
 }
+
+func (l Linter) needsExplanation(linters []string) bool {
+	if len(linters) == 0 {
+		return true // if no linters are mentioned, we must have explanation
+	}
+
+	// otherwise, check if we are excluding all the mentioned linters
+	for _, ll := range linters {
+		if !l.excludeByLinter[ll] { // if a linter does require explanation
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkExplanationContent checks the explanation pattern and the expiration date of a directive.
+// The missing explanations are reported by the NeedsExplanation check.
+func (l Linter) checkExplanationContent(base *BaseIssue, explanation string, linters []string) []Issue {
+	var issues []Issue
+
+	explanation = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(explanation), "//"))
+
+	expiration, hasExpiration, err := ParseExpiration(explanation)
+
+	switch {
+	case err != nil:
+		issues = append(issues, InvalidExpiration{BaseIssue: *base})
+
+	case hasExpiration && !l.now.Before(expiration):
+		issues = append(issues, Expired{BaseIssue: *base, date: expiration.AddDate(0, 0, -1).Format(ExpirationLayout)})
+	}
+
+	if explanation == "" || !l.needsExplanation(linters) {
+		return issues
+	}
+
+	if l.explanationPattern != nil && !l.explanationPattern.MatchString(explanation) {
+		issues = append(issues, ExplanationMismatch{BaseIssue: *base, pattern: l.explanationPattern.String()})
+	}
+
+	if (l.needs&NeedsExpiration) != 0 && !hasExpiration && err == nil {
+		issues = append(issues, NoExpiration{BaseIssue: *base})
+	}
+
+	return issues
+}
+
+// isExpired reports whether the explanation of a directive contains an expiration date in the past.
+func (l Linter) isExpired(explanation string) bool {
+	expiration, hasExpiration, err := ParseExpiration(explanation)
+
+	return err == nil && hasExpiration && !l.now.Before(expiration)
+}
+
+// runRegions checks the regions between the `//golangci:disable` and `//golangci:enable` directives.
+// The unused regions are detected like the unused nolint directives: by the nolint processor.
+func (l Linter) runRegions(fset *token.FileSet, file *ast.File) []Issue {
+	var issues []Issue
+
+	var inProgress []BaseIssue
+
+	for _, c := range file.Comments {
+		for _, comment := range c.List {
+			directive, ok := ParseRegionDirective(comment.Text)
+			if !ok {
+				continue
+			}
+
+			base := BaseIssue{
+				fullDirective:                     comment.Text,
+				directiveWithOptionalLeadingSpace: directive.Prefix(),
+				position:                          fset.Position(comment.Pos()),
+			}
+
+			if directive.Enable {
+				if len(inProgress) == 0 {
+					issues = append(issues, UnexpectedRegionEnd{BaseIssue: base})
+				}
+
+				inProgress = nil
+
+				continue
+			}
+
+			inProgress = append(inProgress, base)
+
+			var linters, rules []string
+			for _, item := range strings.Split(directive.Linters, ",") {
+				if item = strings.TrimSpace(item); item != "" && item != "all" {
+					linter, rule, _ := strings.Cut(item, "(")
+					linters = append(linters, linter)
+					rules = append(rules, strings.TrimSuffix(rule, ")"))
+				}
+			}
+
+			issues = append(issues, l.checkExplanationContent(&base, directive.Explanation, linters)...)
+
+			if (l.needs&NeedsUnused) == 0 || l.isExpired(directive.Explanation) {
+				continue
+			}
+
+			if len(linters) == 0 {
+				issues = append(issues, UnusedCandidate{BaseIssue: base})
+				continue
+			}
+
+			for i, linter := range linters {
+				issues = append(issues, UnusedCandidate{BaseIssue: base, ExpectedLinter: linter, ExpectedRule: rules[i]})
+			}
+		}
+	}
+
+	for _, base := range inProgress {
+		issues = append(issues, UnterminatedRegion{BaseIssue: base})
+	}
+
+	return issues
+}
@@ -20,6 +20,7 @@ func TestLinter_Run(t *testing.T) {
 		desc     string
 		needs    Needs
 		excludes []string
+		pattern  string
 		contents string
 		expected []issueWithReplacement
 	}{
@@ -225,13 +226,160 @@ func foo() {
 				},
 			},
 		},
+		{
+			desc:  "needs unused with specific rules of linters",
+			needs: NeedsUnused,
+			contents: `
+package bar
+
+func foo() {
+  bad() //nolint:gosec(G304),staticcheck(SA1019)
+  bad() //nolint:revive(var-naming)
+}`,
+			expected: []issueWithReplacement{
+				{
+					issue: "directive `//nolint:gosec(G304),staticcheck(SA1019)` is unused for rule \"G304\" of linter \"gosec\" at testing.go:5:9",
+				},
+				{
+					issue: "directive `//nolint:gosec(G304),staticcheck(SA1019)` is unused for rule \"SA1019\" of linter \"staticcheck\" at testing.go:5:9",
+				},
+				{
+					issue: "directive `//nolint:revive(var-naming)` is unused for rule \"var-naming\" of linter \"revive\" at testing.go:6:9",
+					replacement: &result.Replacement{
+						Inline: &result.InlineFix{
+							StartCol:  8,
+							Length:    27,
+							NewString: "",
+						},
+					},
+				},
+			},
+		},
+		{
+			desc:     "when no explanation is needed for a linter restricted to a rule",
+			needs:    NeedsExplanation,
+			excludes: []string{"gosec"},
+			contents: `
+package bar
+
+func foo() {
+  good() //nolint:gosec(G304)
+  bad() //nolint:staticcheck(SA1019)
+  bad() //nolint:gosec(G304 G401)
+}`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:staticcheck(SA1019)` should provide explanation such as `//nolint:staticcheck(SA1019) // this is why` at testing.go:6:9"},
+				{issue: "directive `//nolint:gosec(G304 G401)` should match `//nolint[:<comma-separated-linters>] [// <explanation>]` at testing.go:7:9"},
+			},
+		},
+		{
+			desc: "regions must be terminated",
+			contents: `
+package bar
+
+//golangci:enable
+
+//golangci:disable lll // generated table
+var table = []string{}
+//golangci:enable
+
+//golangci:disable
+var other = []string{}
+`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//golangci:enable` doesn't terminate any `//golangci:disable` directive at testing.go:4:1"},
+				{issue: "directive `//golangci:disable` is not terminated by `//golangci:enable` at testing.go:10:1"},
+			},
+		},
+		{
+			desc:  "needs unused with regions",
+			needs: NeedsUnused,
+			contents: `
+package bar
+
+//golangci:disable lll,gosec(G304)
+var table = []string{}
+//golangci:enable
+`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//golangci:disable lll,gosec(G304)` is unused for linter \"lll\" at testing.go:4:1"},
+				{issue: "directive `//golangci:disable lll,gosec(G304)` is unused for rule \"G304\" of linter \"gosec\" at testing.go:4:1"},
+			},
+		},
+		{
+			desc:     "when the explanation doesn't match the pattern",
+			needs:    NeedsExplanation,
+			excludes: []string{"lll"},
+			pattern:  `^[A-Z]+-\d+`,
+			contents: `
+package bar
+
+func foo() {
+  good() //nolint:errcheck // JIRA-123 the error is always nil
+  bad() //nolint:errcheck // the error is always nil
+  good() //nolint:lll // too long
+  bad() //nolint:errcheck
+}`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:errcheck // the error is always nil` should provide explanation matching `^[A-Z]+-\\d+` at testing.go:6:9"},
+				{issue: "directive `//nolint:errcheck` should provide explanation such as `//nolint:errcheck // this is why` at testing.go:8:9"},
+			},
+		},
+		{
+			desc:  "when an expiration date is required",
+			needs: NeedsExpiration,
+			contents: `
+package bar
+
+func foo() {
+  good() //nolint:errcheck // JIRA-123 until=2999-12-01
+  bad() //nolint:errcheck // JIRA-123
+  bad() //nolint:errcheck // JIRA-123 until=2020-12-01
+  bad() //nolint:errcheck // JIRA-123 until=tomorrow
+}
+
+//golangci:disable lll // until=2020-01-02
+var table = []string{}
+//golangci:enable
+`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:errcheck // JIRA-123` should provide expiration date such as `until=YYYY-MM-DD` in its explanation at testing.go:6:9"},
+				{issue: "directive `//nolint:errcheck // JIRA-123 until=2020-12-01` expired on 2020-12-01, it's no longer applied at testing.go:7:9"},
+				{issue: "directive `//nolint:errcheck // JIRA-123 until=tomorrow` has an invalid expiration date (expected format `until=YYYY-MM-DD`) at testing.go:8:9"},
+				{issue: "directive `//golangci:disable lll // until=2020-01-02` expired on 2020-01-02, it's no longer applied at testing.go:11:1"},
+			},
+		},
+		{
+			desc:  "expired directives are not reported as unused",
+			needs: NeedsUnused,
+			contents: `
+package bar
+
+func foo() {
+  good() //nolint:errcheck // JIRA-123 until=2999-12-01
+  bad() //nolint:errcheck // JIRA-123 until=2020-12-01
+}
+
+//golangci:disable lll // until=2020-01-02
+var table = []string{}
+//golangci:enable
+`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:errcheck // JIRA-123 until=2999-12-01` is unused for linter \"errcheck\" at testing.go:5:10", replacement: &result.Replacement{
+					Inline: &result.InlineFix{StartCol: 9, Length: 46},
+				}},
+				{issue: "directive `//nolint:errcheck // JIRA-123 until=2020-12-01` expired on 2020-12-01, it's no longer applied at testing.go:6:9"},
+				{issue: "directive `//golangci:disable lll // until=2020-01-02` expired on 2020-01-02, it's no longer applied at testing.go:9:1"},
+			},
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			linter, _ := NewLinter(test.needs, test.excludes)
+			linter, err := NewLinter(test.needs, test.excludes, test.pattern)
+			require.NoError(t, err)
 
 			fset := token.NewFileSet()
 			expr, err := parser.ParseFile(fset, "testing.go", test.contents, parser.ParseComments)
@@ -253,3 +401,50 @@ func foo() {
 		})
 	}
 }
+
+func TestParseRegionDirective(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		text     string
+		expected RegionDirective
+		ok       bool
+	}{
+		{
+			desc: "not a directive",
+			text: "//nolint:lll",
+		},
+		{
+			desc: "unknown action",
+			text: "//golangci:disabled lll",
+		},
+		{
+			desc:     "disable all",
+			text:     "//golangci:disable",
+			expected: RegionDirective{},
+			ok:       true,
+		},
+		{
+			desc:     "disable linters with explanation",
+			text:     "//golangci:disable\tgosec(G304), lll // JIRA-123",
+			expected: RegionDirective{Linters: "gosec(G304), lll ", Explanation: "// JIRA-123"},
+			ok:       true,
+		},
+		{
+			desc:     "enable",
+			text:     "//golangci:enable // end",
+			expected: RegionDirective{Enable: true, Explanation: "// end"},
+			ok:       true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			directive, ok := ParseRegionDirective(test.text)
+			require.Equal(t, test.ok, ok)
+
+			assert.Equal(t, test.expected, directive)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/ast"
 	"sync"
+	"time"
 
 	"golang.org/x/tools/go/analysis"
 
@@ -51,6 +52,23 @@ func New(settings *config.NoLintLintSettings) *goanalysis.Linter {
 	}).WithLoadMode(goanalysis.LoadModeSyntax)
 }
 
+// ParseExpiration returns the expiration date of a directive, from its explanation (ex: `// JIRA-123 until=2026-12-01`).
+// It returns false if the explanation doesn't contain a valid expiration date.
+func ParseExpiration(explanation string) (time.Time, bool) {
+	date, ok, err := internal.ParseExpiration(explanation)
+
+	return date, ok && err == nil
+}
+
+// RegionDirective is a `//golangci:disable [linters]` or `//golangci:enable` directive.
+type RegionDirective = internal.RegionDirective
+
+// ParseRegionDirective parses a `//golangci:disable [linters]` or `//golangci:enable` directive.
+// It returns false if the comment is not a region directive.
+func ParseRegionDirective(text string) (RegionDirective, bool) {
+	return internal.ParseRegionDirective(text)
+}
+
 func runNoLintLint(pass *analysis.Pass, settings *config.NoLintLintSettings) ([]goanalysis.Issue, error) {
 	var needs internal.Needs
 	if settings.RequireExplanation {
@@ -62,8 +80,11 @@ func runNoLintLint(pass *analysis.Pass, settings *config.NoLintLintSettings) ([]
 	if !settings.AllowUnused {
 		needs |= internal.NeedsUnused
 	}
+	if settings.RequireExpiration {
+		needs |= internal.NeedsExpiration
+	}
 
-	lnt, err := internal.NewLinter(needs, settings.AllowNoExplanation)
+	lnt, err := internal.NewLinter(needs, settings.AllowNoExplanation, settings.ExplanationPattern)
 	if err != nil {
 		return nil, err
 	}
@@ -82,9 +103,10 @@ func runNoLintLint(pass *analysis.Pass, settings *config.NoLintLintSettings) ([]
 
 	for _, i := range lintIssues {
 		expectNoLint := false
-		var expectedNolintLinter string
+		var expectedNolintLinter, expectedNolintRule string
 		if ii, ok := i.(internal.UnusedCandidate); ok {
 			expectedNolintLinter = ii.ExpectedLinter
+			expectedNolintRule = ii.ExpectedRule
 			expectNoLint = true
 		}
 
@@ -94,6 +116,7 @@ func runNoLintLint(pass *analysis.Pass, settings *config.NoLintLintSettings) ([]
 			Pos:                  i.Position(),
 			ExpectNoLint:         expectNoLint,
 			ExpectedNoLintLinter: expectedNolintLinter,
+			ExpectedNoLintRule:   expectedNolintRule,
 			Replacement:          i.Replacement(),
 		}
 
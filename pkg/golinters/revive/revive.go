@@ -168,6 +168,7 @@ func toIssue(pass *analysis.Pass, object *jsonObject) goanalysis.Issue {
 	return goanalysis.NewIssue(&result.Issue{
 		Severity: string(object.Severity),
 		Text:     fmt.Sprintf("%s: %s", object.RuleName, object.Failure.Failure),
+		RuleID:   object.RuleName,
 		Pos: token.Position{
 			Filename: object.Position.Start.Filename,
 			Line:     object.Position.Start.Line,
@@ -42,6 +42,15 @@ type Deprecation struct {
 	Level       DeprecationLevel
 }
 
+// Conflict describes a linter that can contradict (or duplicate) the reports of another linter.
+type Conflict struct {
+	Linter string
+	// Check returns the reason of the conflict, or an empty string if the settings of the linters are compatible.
+	Check func(cfg *config.Config) string
+	// Duplicate is true when the linters report the same issues, instead of contradictory issues.
+	Duplicate bool
+}
+
 type Config struct {
 	Linter           Linter
 	EnabledByDefault bool
@@ -59,6 +68,8 @@ type Config struct {
 
 	Since       string
 	Deprecation *Deprecation
+
+	Conflicts []Conflict
 }
 
 func (lc *Config) WithEnabledByDefault() *Config {
@@ -122,6 +133,16 @@ func (lc *Config) WithSince(version string) *Config {
 	return lc
 }
 
+func (lc *Config) WithConflict(name string, check func(cfg *config.Config) string) *Config {
+	lc.Conflicts = append(lc.Conflicts, Conflict{Linter: name, Check: check})
+	return lc
+}
+
+func (lc *Config) WithDuplicate(name string, check func(cfg *config.Config) string) *Config {
+	lc.Conflicts = append(lc.Conflicts, Conflict{Linter: name, Check: check, Duplicate: true})
+	return lc
+}
+
 func (lc *Config) Deprecated(message, version, replacement string, level DeprecationLevel) *Config {
 	lc.Deprecation = &Deprecation{
 		Since:       version,
@@ -1,7 +1,11 @@
 package lintersdb
 
 import (
+	"fmt"
+	"regexp"
+
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/goanalysis"
 	"github.com/golangci/golangci-lint/pkg/golinters"
 	"github.com/golangci/golangci-lint/pkg/golinters/asasalint"
 	"github.com/golangci/golangci-lint/pkg/golinters/asciicheck"
@@ -132,6 +136,11 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 
 	const megacheckName = "megacheck"
 
+	overrides, err := newSettingsOverrides(&cfg.LintersSettings)
+	if err != nil {
+		return nil, err
+	}
+
 	// The linters are sorted in the alphabetical order (case-insensitive).
 	// When a new linter is added the version in `WithSince(...)` must be the next minor version of golangci-lint.
 	return []*linter.Config{
@@ -207,7 +216,9 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithPresets(linter.PresetStyle).
 			WithURL("https://github.com/alexkohler/dogsled"),
 
-		linter.NewConfig(dupl.New(&cfg.LintersSettings.Dupl)).
+		linter.NewConfig(overrides.build("dupl", func(settings *config.LintersSettings) *goanalysis.Linter {
+			return dupl.New(&settings.Dupl)
+		})).
 			WithSince("v1.0.0").
 			WithPresets(linter.PresetStyle).
 			WithURL("https://github.com/mibk/dupl"),
@@ -300,7 +311,9 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithLoadForGoAnalysis().
 			WithURL("https://github.com/Crocmagnon/fatcontext"),
 
-		linter.NewConfig(funlen.New(&cfg.LintersSettings.Funlen)).
+		linter.NewConfig(overrides.build("funlen", func(settings *config.LintersSettings) *goanalysis.Linter {
+			return funlen.New(&settings.Funlen)
+		})).
 			WithSince("v1.18.0").
 			WithPresets(linter.PresetComplexity).
 			WithURL("https://github.com/ultraware/funlen"),
@@ -309,6 +322,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithSince("v1.30.0").
 			WithPresets(linter.PresetFormatting, linter.PresetImport).
 			WithAutoFix().
+			WithConflict("goimports", gciGoimportsConflict).
 			WithURL("https://github.com/daixiang0/gci"),
 
 		linter.NewConfig(ginkgolinter.New(&cfg.LintersSettings.GinkgoLinter)).
@@ -338,7 +352,9 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithLoadForGoAnalysis().
 			WithURL("https://github.com/alecthomas/go-check-sumtype"),
 
-		linter.NewConfig(gocognit.New(&cfg.LintersSettings.Gocognit)).
+		linter.NewConfig(overrides.build("gocognit", func(settings *config.LintersSettings) *goanalysis.Linter {
+			return gocognit.New(&settings.Gocognit)
+		})).
 			WithSince("v1.20.0").
 			WithPresets(linter.PresetComplexity).
 			WithURL("https://github.com/uudashr/gocognit"),
@@ -388,6 +404,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithSince("v1.28.0").
 			WithPresets(linter.PresetFormatting).
 			WithAutoFix().
+			WithDuplicate("gofmt", gofumptGofmtDuplicate).
 			WithURL("https://github.com/mvdan/gofumpt"),
 
 		linter.NewConfig(goheader.New(&cfg.LintersSettings.Goheader)).
@@ -521,7 +538,9 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithLoadForGoAnalysis().
 			WithURL("https://github.com/butuzov/ireturn"),
 
-		linter.NewConfig(lll.New(&cfg.LintersSettings.Lll)).
+		linter.NewConfig(overrides.build("lll", func(settings *config.LintersSettings) *goanalysis.Linter {
+			return lll.New(&settings.Lll)
+		})).
 			WithSince("v1.8.0").
 			WithPresets(linter.PresetStyle),
 
@@ -593,6 +612,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 		linter.NewConfig(nlreturn.New(&cfg.LintersSettings.Nlreturn)).
 			WithSince("v1.30.0").
 			WithPresets(linter.PresetStyle).
+			WithDuplicate("wsl", nlreturnWSLDuplicate).
 			WithURL("https://github.com/ssgreg/nlreturn"),
 
 		linter.NewConfig(noctx.New()).
@@ -605,6 +625,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithSince("v1.46.0").
 			WithLoadForGoAnalysis().
 			WithPresets(linter.PresetStyle).
+			WithDuplicate("nakedret", nonamedreturnsNakedretDuplicate).
 			WithURL("https://github.com/firefart/nonamedreturns"),
 
 		linter.NewConfig(linter.NewNoopDeprecated("nosnakecase", cfg, linter.DeprecationError)).
@@ -810,10 +831,13 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithURL("https://github.com/opennota/check").
 			DeprecatedError("The owner seems to have abandoned the linter.", "v1.49.0", "unused"),
 
-		linter.NewConfig(varnamelen.New(&cfg.LintersSettings.Varnamelen)).
+		linter.NewConfig(overrides.build("varnamelen", func(settings *config.LintersSettings) *goanalysis.Linter {
+			return varnamelen.New(&settings.Varnamelen)
+		})).
 			WithSince("v1.43.0").
 			WithPresets(linter.PresetStyle).
 			WithLoadForGoAnalysis().
+			WithConflict("predeclared", varnamelenPredeclaredConflict).
 			WithURL("https://github.com/blizzy78/varnamelen"),
 
 		linter.NewConfig(wastedassign.New()).
@@ -853,3 +877,64 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithURL("https://github.com/golangci/golangci-lint/tree/master/pkg/golinters/nolintlint/internal"),
 	}, nil
 }
+
+// settingsOverrides contains the linters settings overridden for a subset of the files.
+type settingsOverrides struct {
+	base   *config.LintersSettings
+	scopes map[string][]settingsScope // linter name -> scopes
+}
+
+type settingsScope struct {
+	id       string
+	path     *regexp.Regexp
+	settings *config.LintersSettings
+}
+
+func newSettingsOverrides(base *config.LintersSettings) (*settingsOverrides, error) {
+	overrides := &settingsOverrides{
+		base:   base,
+		scopes: make(map[string][]settingsScope),
+	}
+
+	for i, override := range base.Overrides {
+		path, err := regexp.Compile(override.PathPattern())
+		if err != nil {
+			return nil, fmt.Errorf("linters settings override #%d: invalid path: %w", i, err)
+		}
+
+		settings, err := override.Apply(base)
+		if err != nil {
+			return nil, fmt.Errorf("linters settings override #%d: %w", i, err)
+		}
+
+		scope := settingsScope{id: override.ID(), path: path, settings: settings}
+
+		for _, name := range override.Linters() {
+			overrides.scopes[name] = append(overrides.scopes[name], scope)
+		}
+	}
+
+	return overrides, nil
+}
+
+// build creates the linter with the base settings,
+// and one more instance of the linter for each override of its settings.
+func (o *settingsOverrides) build(name string, newLinter func(settings *config.LintersSettings) *goanalysis.Linter) linter.Linter {
+	base := newLinter(o.base)
+
+	scopes := o.scopes[name]
+	if len(scopes) == 0 {
+		return base
+	}
+
+	linterScopes := make([]goanalysis.LinterScope, 0, len(scopes))
+	for _, scope := range scopes {
+		linterScopes = append(linterScopes, goanalysis.LinterScope{
+			ID:     scope.id,
+			Path:   scope.path,
+			Linter: newLinter(scope.settings),
+		})
+	}
+
+	return goanalysis.NewScopedLinter(base, linterScopes)
+}
@@ -0,0 +1,83 @@
+package lintersdb
+
+import (
+	"fmt"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+// The duplicates don't depend on the settings: the checks shared by the linters can't be disabled.
+
+func nlreturnWSLDuplicate(_ *config.Config) string {
+	return "the return statements cuddled in blocks of more than 2 lines are reported by both linters"
+}
+
+func nonamedreturnsNakedretDuplicate(_ *config.Config) string {
+	return "the naked returns reported by nakedret are in functions with named results, already reported by nonamedreturns"
+}
+
+func gofumptGofmtDuplicate(_ *config.Config) string {
+	return "gofumpt is a stricter gofmt, the formatting issues are reported twice and the fixes overlap"
+}
+
+func varnamelenPredeclaredConflict(cfg *config.Config) string {
+	ignored := strings.Split(cfg.LintersSettings.Predeclared.Ignore, ",")
+
+	var names []string
+
+	for _, name := range cfg.LintersSettings.Varnamelen.IgnoreNames {
+		if types.Universe.Lookup(name) == nil || slices.Contains(ignored, name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("the names ignored by varnamelen are reported by predeclared: %s", strings.Join(names, ", "))
+}
+
+func gciGoimportsConflict(cfg *config.Config) string {
+	var gciPrefixes []string
+
+	for _, section := range cfg.LintersSettings.Gci.Sections {
+		section = strings.ToLower(strings.TrimSpace(section))
+
+		if !strings.HasPrefix(section, "prefix(") {
+			continue
+		}
+
+		for _, prefix := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(section, "prefix("), ")"), ",") {
+			gciPrefixes = append(gciPrefixes, strings.TrimSpace(prefix))
+		}
+	}
+
+	for _, prefix := range strings.Split(cfg.LintersSettings.Gci.LocalPrefixes, ",") {
+		gciPrefixes = append(gciPrefixes, strings.ToLower(strings.TrimSpace(prefix)))
+	}
+
+	var missing []string
+
+	for _, prefix := range strings.Split(cfg.LintersSettings.Goimports.LocalPrefixes, ",") {
+		prefix = strings.TrimSpace(prefix)
+
+		if prefix == "" || slices.Contains(gciPrefixes, strings.ToLower(prefix)) {
+			continue
+		}
+
+		missing = append(missing, prefix)
+	}
+
+	if len(missing) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("the imports with the prefixes %s are grouped by goimports (local-prefixes) but not by gci (sections)",
+		strings.Join(missing, ", "))
+}
@@ -35,6 +35,12 @@ func (v Validator) Validate(cfg *config.Config) error {
 		}
 	}
 
+	if err := v.validateLintGenerated(&cfg.Issues); err != nil {
+		return err
+	}
+
+	v.conflictingLinters(cfg)
+
 	return nil
 }
 
@@ -71,6 +77,24 @@ func (v Validator) validateLintersNames(cfg *config.Linters) error {
 	return nil
 }
 
+// validateLintGenerated checks the names of the linters allowed to report issues inside the generated files.
+func (v Validator) validateLintGenerated(cfg *config.Issues) error {
+	var unknownNames []string
+
+	for _, name := range cfg.LintGenerated {
+		if v.m.GetLinterConfigs(name) == nil {
+			unknownNames = append(unknownNames, name)
+		}
+	}
+
+	if len(unknownNames) > 0 {
+		return fmt.Errorf("unknown linters in issues.lint-generated: '%v', run 'golangci-lint help linters' to see the list of supported linters",
+			strings.Join(unknownNames, ","))
+	}
+
+	return nil
+}
+
 func (Validator) validatePresets(cfg *config.Linters) error {
 	presets := AllPresets()
 
@@ -118,3 +142,58 @@ func (v Validator) alternativeNamesDeprecation(cfg *config.Linters) error {
 
 	return nil
 }
+
+// conflictingLinters warns about the enabled linters with incompatible settings, or reporting the same issues.
+func (v Validator) conflictingLinters(cfg *config.Config) {
+	if v.m.cfg.InternalTest || v.m.cfg.InternalCmdTest || os.Getenv(logutils.EnvTestRun) == "1" {
+		return
+	}
+
+	for _, conflict := range v.getConflicts(cfg) {
+		if conflict.Duplicate {
+			v.m.log.Warnf("The linters %q and %q report the same issues: %s.", conflict.Linters[0], conflict.Linters[1], conflict.Reason)
+			continue
+		}
+
+		v.m.log.Warnf("The linters %q and %q are conflicting: %s.", conflict.Linters[0], conflict.Linters[1], conflict.Reason)
+	}
+}
+
+// getConflicts returns the conflicts between the enabled linters.
+func (v Validator) getConflicts(cfg *config.Config) []lintersConflict {
+	enabled := v.m.build(v.m.GetAllEnabledByDefaultLinters())
+
+	var conflicts []lintersConflict
+
+	for _, lc := range v.m.GetAllSupportedLinterConfigs() {
+		if _, ok := enabled[lc.Name()]; !ok {
+			continue
+		}
+
+		for _, conflict := range lc.Conflicts {
+			if _, ok := enabled[conflict.Linter]; !ok {
+				continue
+			}
+
+			reason := conflict.Check(cfg)
+			if reason == "" {
+				continue
+			}
+
+			conflicts = append(conflicts, lintersConflict{
+				Linters:   [2]string{lc.Name(), conflict.Linter},
+				Reason:    reason,
+				Duplicate: conflict.Duplicate,
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// lintersConflict is a pair of enabled linters with incompatible settings, or reporting the same issues.
+type lintersConflict struct {
+	Linters   [2]string
+	Reason    string
+	Duplicate bool
+}
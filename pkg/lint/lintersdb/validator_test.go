@@ -3,6 +3,7 @@ package lintersdb
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/golangci/golangci-lint/pkg/config"
@@ -183,6 +184,20 @@ func TestValidator_validateLintersNames_error(t *testing.T) {
 	}
 }
 
+func TestValidator_validateLintGenerated(t *testing.T) {
+	m, err := NewManager(nil, nil, NewLinterBuilder())
+	require.NoError(t, err)
+
+	v := NewValidator(m)
+
+	err = v.validateLintGenerated(&config.Issues{LintGenerated: []string{"gosec", "govet"}})
+	require.NoError(t, err)
+
+	err = v.validateLintGenerated(&config.Issues{LintGenerated: []string{"gosec", "golangci", "example"}})
+	require.EqualError(t, err, "unknown linters in issues.lint-generated: 'golangci,example', "+
+		"run 'golangci-lint help linters' to see the list of supported linters")
+}
+
 func TestValidator_validatePresets(t *testing.T) {
 	v := NewValidator(nil)
 
@@ -234,3 +249,90 @@ func TestValidator_alternativeNamesDeprecation(t *testing.T) {
 	err = v.alternativeNamesDeprecation(cfg)
 	require.NoError(t, err)
 }
+
+func TestValidator_getConflicts(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      *config.Config
+		expected []lintersConflict
+	}{
+		{
+			desc: "not enabled",
+			cfg: &config.Config{
+				InternalTest: true, // Disables the warnings.
+				Linters:      config.Linters{DisableAll: true, Enable: []string{"gofmt", "nlreturn"}},
+			},
+		},
+		{
+			desc: "duplicates",
+			cfg: &config.Config{
+				InternalTest: true,
+				Linters:      config.Linters{DisableAll: true, Enable: []string{"gofmt", "gofumpt", "nlreturn", "wsl"}},
+				LintersSettings: config.LintersSettings{
+					Gofumpt:  config.GofumptSettings{ExtraRules: true},
+					Nlreturn: config.NlreturnSettings{BlockSize: 2},
+				},
+			},
+			expected: []lintersConflict{
+				{
+					Linters:   [2]string{"gofumpt", "gofmt"},
+					Reason:    "gofumpt is a stricter gofmt, the formatting issues are reported twice and the fixes overlap",
+					Duplicate: true,
+				},
+				{
+					Linters:   [2]string{"nlreturn", "wsl"},
+					Reason:    "the return statements cuddled in blocks of more than 2 lines are reported by both linters",
+					Duplicate: true,
+				},
+			},
+		},
+		{
+			desc: "compatible settings",
+			cfg: &config.Config{
+				InternalTest: true,
+				Linters:      config.Linters{DisableAll: true, Enable: []string{"gci", "goimports"}},
+				LintersSettings: config.LintersSettings{
+					Gci:       config.GciSettings{Sections: []string{"standard", "default", "prefix(github.com/foo/bar)"}},
+					Goimports: config.GoImportsSettings{LocalPrefixes: "github.com/foo/bar"},
+				},
+			},
+		},
+		{
+			desc: "incompatible settings",
+			cfg: &config.Config{
+				InternalTest: true,
+				Linters:      config.Linters{DisableAll: true, Enable: []string{"gci", "goimports", "varnamelen", "predeclared"}},
+				LintersSettings: config.LintersSettings{
+					Gci:         config.GciSettings{Sections: []string{"standard", "default", "prefix(github.com/foo)"}},
+					Goimports:   config.GoImportsSettings{LocalPrefixes: "github.com/foo,github.com/foo/bar"},
+					Varnamelen:  config.VarnamelenSettings{IgnoreNames: []string{"err", "len", "new"}},
+					Predeclared: config.PredeclaredSettings{Ignore: "new"},
+				},
+			},
+			expected: []lintersConflict{
+				{
+					Linters: [2]string{"gci", "goimports"},
+					Reason: "the imports with the prefixes github.com/foo/bar are grouped by goimports (local-prefixes) " +
+						"but not by gci (sections)",
+				},
+				{
+					Linters: [2]string{"varnamelen", "predeclared"},
+					Reason:  "the names ignored by varnamelen are reported by predeclared: len",
+				},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewManager(nil, test.cfg, NewLinterBuilder())
+			require.NoError(t, err)
+
+			conflicts := NewValidator(m).getConflicts(test.cfg)
+
+			assert.Equal(t, test.expected, conflicts)
+		})
+	}
+}
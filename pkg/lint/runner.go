@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"runtime/debug"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/golangci/golangci-lint/internal/errorutil"
 	"github.com/golangci/golangci-lint/pkg/config"
@@ -24,22 +27,69 @@ type processorStat struct {
 	outCount int
 }
 
+// unusedRulesReporter is implemented by the processors based on rules (exclude rules, severity rules).
+type unusedRulesReporter interface {
+	UnusedRules(enabledLinters map[string]*linter.Config) []string
+}
+
+// suppressionsReporter is implemented by the processors that hide issues (nolint, exclusions, limits of issues).
+type suppressionsReporter interface {
+	Suppressions() []result.Suppression
+}
+
+// IssuesHandler receives the issues of a linter as soon as they are processed.
+type IssuesHandler func(issues []result.Issue) error
+
 type Runner struct {
 	Log logutils.Log
 
 	lintCtx    *linter.Context
 	Processors []processors.Processor
+
+	// runProcessorsFrom is the index of the first processor of the whole run.
+	runProcessorsFrom int
+	// newRunProcessors creates the processors of the whole run.
+	newRunProcessors func() []processors.Processor
+
+	// issuesHandler enables the incremental processing of the issues (linter by linter).
+	issuesHandler IssuesHandler
+	// streamProcessors are the processors of the whole run used for the issues sent to the handler.
+	// They don't change the processing of the whole run, and they are not finished:
+	// their statistics are already logged by the processors of the whole run.
+	streamProcessors []processors.Processor
+
+	enabledLinters map[string]*linter.Config
+
+	// partialRun is true when only a part of the issues is reported (some packages, or the new issues),
+	// so the rules that don't match any issue can be useful for the rest of the project.
+	partialRun bool
+
+	failOnUnusedRules bool
+	warnUnusedRules   bool
+	unusedRules       []string
+
+	explainHidden bool
+	hiddenIssues  []result.HiddenIssue
 }
 
 func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *goutil.Env,
 	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
 	dbManager *lintersdb.Manager, lintCtx *linter.Context,
 ) (*Runner, error) {
+	if cfg.Issues.FailOnExpiredRules {
+		if expired := cfg.GetExpiredRules(time.Now()); len(expired) > 0 {
+			return nil, fmt.Errorf("expired rules: %s", joinExpiredRules(expired))
+		}
+	}
+
 	// Beware that some processors need to add the path prefix when working with paths
 	// because they get invoked before the path prefixer (exclude and severity rules)
 	// or process other paths (skip files).
 	files := fsutils.NewFiles(lineCache, cfg.Output.PathPrefix)
 
+	// The symbols are shared by the exclude rules and the severity rules: each file is parsed once.
+	symbols := processors.NewSymbolIndex(log)
+
 	skipFilesProcessor, err := processors.NewSkipFiles(cfg.Issues.ExcludeFiles, cfg.Output.PathPrefix)
 	if err != nil {
 		return nil, err
@@ -55,43 +105,31 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 		return nil, err
 	}
 
+	autogeneratedExcludeProcessor, err := processors.NewAutogeneratedExclude(&cfg.Issues)
+	if err != nil {
+		return nil, err
+	}
+
 	enabledLinters, err := dbManager.GetEnabledLintersMap()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get enabled linters: %w", err)
 	}
 
-	return &Runner{
-		Processors: []processors.Processor{
-			processors.NewCgo(goenv),
-
-			// Must go after Cgo.
-			processors.NewFilenameUnadjuster(lintCtx.Packages, log.Child(logutils.DebugKeyFilenameUnadjuster)),
-
-			// Must go after FilenameUnadjuster.
-			processors.NewInvalidIssue(log.Child(logutils.DebugKeyInvalidIssue)),
-
-			// Must be before diff, nolint and exclude autogenerated processor at least.
-			processors.NewPathPrettifier(),
-			skipFilesProcessor,
-			skipDirsProcessor, // must be after path prettifier
-
-			processors.NewAutogeneratedExclude(cfg.Issues.ExcludeGenerated),
+	// The changes used to find the new issues are computed once for all the sets of processors of the whole run.
+	diffProcessor := processors.NewDiff(cfg)
 
-			// Must be before exclude because users see already marked output and configure excluding by it.
-			processors.NewIdentifierMarker(),
-
-			processors.NewExclude(&cfg.Issues),
-			processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &cfg.Issues),
-			processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters),
+	// The processors of the whole run compare the issues of several linters, or depend on the previous issues.
+	newRunProcessors := func() []processors.Processor {
+		return []processors.Processor{
+			// Must be before UniqByLine to choose the issue to keep between the equivalent issues.
+			processors.NewDedupEquivalent(&cfg.Issues),
 
 			processors.NewUniqByLine(cfg),
-			processors.NewDiff(&cfg.Issues),
+			diffProcessor.Fork(),
 			processors.NewMaxPerFileFromLinter(cfg),
 			processors.NewMaxSameIssues(cfg.Issues.MaxSameIssues, log.Child(logutils.DebugKeyMaxSameIssues), cfg),
 			processors.NewMaxFromLinter(cfg.Issues.MaxIssuesPerLinter, log.Child(logutils.DebugKeyMaxFromLinter), cfg),
 			processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode)),
-			processors.NewPathShortener(),
-			processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &cfg.Severity),
 
 			// The fixer still needs to see paths for the issues that are relative to the current directory.
 			processors.NewFixer(cfg, log, fileCache),
@@ -99,12 +137,67 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 			// Now we can modify the issues for output.
 			processors.NewPathPrefixer(cfg.Output.PathPrefix),
 			processors.NewSortResults(cfg),
-		},
-		lintCtx: lintCtx,
-		Log:     log,
+		}
+	}
+
+	// The processors of the issues only depend on each issue.
+	issueProcessors := []processors.Processor{
+		processors.NewCgo(goenv),
+
+		// Must go after Cgo.
+		processors.NewFilenameUnadjuster(lintCtx.Packages, log.Child(logutils.DebugKeyFilenameUnadjuster)),
+
+		// Must go after FilenameUnadjuster.
+		processors.NewInvalidIssue(log.Child(logutils.DebugKeyInvalidIssue)),
+
+		// Must be before diff, nolint and exclude autogenerated processor at least.
+		processors.NewPathPrettifier(),
+		skipFilesProcessor,
+		skipDirsProcessor, // must be after path prettifier
+
+		autogeneratedExcludeProcessor,
+
+		// Must be before exclude because users see already marked output and configure excluding by it.
+		processors.NewIdentifierMarker(),
+
+		processors.NewExclude(&cfg.Issues),
+		processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, symbols, &cfg.Issues),
+		processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters),
+
+		processors.NewPathShortener(),
+
+		// Must be before DedupEquivalent: the issue to keep depends on the severities defined by the rules.
+		// Like the exclude rules, the severity rules see the issues dropped by the processors of the whole run
+		// (UniqByLine, Max*): a rule matching only the issues above the limits is not reported as unused.
+		processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, symbols, &cfg.Severity),
+	}
+
+	return &Runner{
+		Processors:        append(issueProcessors, newRunProcessors()...),
+		runProcessorsFrom: len(issueProcessors),
+		newRunProcessors:  newRunProcessors,
+		lintCtx:           lintCtx,
+		Log:               log,
+		enabledLinters:    enabledLinters,
+		partialRun:        isPartialRun(cfg, args),
+		failOnUnusedRules: cfg.Issues.FailOnUnusedRules,
+		// The test configurations are shared between the test cases, so some of their rules are never used.
+		warnUnusedRules: !cfg.InternalTest && !cfg.InternalCmdTest && os.Getenv(logutils.EnvTestRun) != "1",
+		explainHidden:   cfg.Output.ExplainHidden,
 	}, nil
 }
 
+// SetIssuesHandler enables the incremental processing of the issues:
+// the issues of each linter go through the processors as soon as the linter is done, then they are sent to the handler.
+// The issues sent to the handler are provisional: the handler only sees the issues of one linter at a time
+// (e.g. the equivalent issues of several linters are not merged).
+// The issues returned by Run are the final result: they are processed with the issues of all the linters.
+// The processors must not be used to fix the issues (the fixes of a linter would shift the positions of the next issues).
+func (r *Runner) SetIssuesHandler(handler IssuesHandler) {
+	r.issuesHandler = handler
+	r.streamProcessors = r.newRunProcessors()
+}
+
 func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Issue, error) {
 	sw := timeutils.NewStopwatch("linters", r.Log)
 	defer sw.Print()
@@ -114,6 +207,8 @@ func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Is
 		issues     []result.Issue
 	)
 
+	pr := r.newProcessing()
+
 	for _, lc := range linters {
 		linterIssues, err := timeutils.TrackStage(sw, lc.Name(), func() ([]result.Issue, error) {
 			return r.runLinterSafe(ctx, r.lintCtx, lc)
@@ -125,10 +220,77 @@ func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Is
 			continue
 		}
 
+		if r.issuesHandler != nil {
+			linterIssues = pr.processIssues(linterIssues)
+
+			if err := r.issuesHandler(pr.processStream(linterIssues)); err != nil {
+				r.Log.Warnf("Can't handle the issues of the linter %s: %v", lc.Linter.Name(), err)
+			}
+		}
+
 		issues = append(issues, linterIssues...)
 	}
 
-	return r.processLintResults(issues), lintErrors
+	if r.issuesHandler == nil {
+		issues = pr.process(issues)
+	} else {
+		issues = pr.processRun(issues)
+	}
+
+	pr.finish()
+
+	// The unused rules can't be detected if some issues are missing.
+	if lintErrors == nil && !r.partialRun {
+		r.checkUnusedRules()
+	}
+
+	return issues, lintErrors
+}
+
+// checkUnusedRules collects the rules that didn't match any issue.
+// It must be called after the processing of the issues.
+func (r *Runner) checkUnusedRules() {
+	for _, p := range r.Processors {
+		if reporter, ok := p.(unusedRulesReporter); ok {
+			r.unusedRules = append(r.unusedRules, reporter.UnusedRules(r.enabledLinters)...)
+		}
+	}
+
+	// The caller reports the unused rules as a failure.
+	if r.failOnUnusedRules || !r.warnUnusedRules {
+		return
+	}
+
+	for _, name := range r.unusedRules {
+		r.Log.Warnf("The rule %s doesn't match any issue, it can be removed.", name)
+	}
+}
+
+// UnusedRules returns the rules that didn't match any issue.
+// The rules are not checked if some linters failed or if only a part of the project is analyzed.
+// It must be called after the processing of the issues.
+func (r *Runner) UnusedRules() []string {
+	return r.unusedRules
+}
+
+// Suppressions returns the issues hidden by the processors.
+// It must be called after the processing of the issues.
+func (r *Runner) Suppressions() []result.Suppression {
+	var suppressions []result.Suppression
+
+	for _, p := range r.Processors {
+		if reporter, ok := p.(suppressionsReporter); ok {
+			suppressions = append(suppressions, reporter.Suppressions()...)
+		}
+	}
+
+	return suppressions
+}
+
+// HiddenIssues returns the issues hidden by the processors (only if `output.explain-hidden` is enabled).
+// It must be called after the processing of the issues.
+func (r *Runner) HiddenIssues() []result.HiddenIssue {
+	return r.hiddenIssues
 }
 
 func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
@@ -172,34 +334,75 @@ func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
 	return issues, nil
 }
 
-func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
-	sw := timeutils.NewStopwatch("processing", r.Log)
+// processing tracks the processing of the issues, which can be done in several steps.
+type processing struct {
+	runner *Runner
+	sw     *timeutils.Stopwatch
 
-	var issuesBefore, issuesAfter int
-	statPerProcessor := map[string]processorStat{}
+	issuesBefore, issuesAfter int
+	statPerProcessor          map[string]processorStat
+}
 
-	var outIssues []result.Issue
-	if len(inIssues) != 0 {
-		issuesBefore += len(inIssues)
-		outIssues = r.processIssues(inIssues, sw, statPerProcessor)
-		issuesAfter += len(outIssues)
+func (r *Runner) newProcessing() *processing {
+	return &processing{
+		runner:           r,
+		sw:               timeutils.NewStopwatch("processing", r.Log),
+		statPerProcessor: map[string]processorStat{},
 	}
+}
 
-	// finalize processors: logging, clearing, no heavy work here
+// process runs all the processors.
+func (pr *processing) process(inIssues []result.Issue) []result.Issue {
+	return pr.processRun(pr.processIssues(inIssues))
+}
 
-	for _, p := range r.Processors {
-		sw.TrackStage(p.Name(), p.Finish)
+// processIssues runs the processors of the issues, it can be called several times.
+func (pr *processing) processIssues(inIssues []result.Issue) []result.Issue {
+	if len(inIssues) == 0 {
+		return nil
 	}
 
-	if issuesBefore != issuesAfter {
-		r.Log.Infof("Issues before processing: %d, after processing: %d", issuesBefore, issuesAfter)
+	pr.issuesBefore += len(inIssues)
+
+	return pr.runner.processIssues(inIssues, pr.runner.Processors[:pr.runner.runProcessorsFrom], pr.sw, pr.statPerProcessor)
+}
+
+// processRun runs the processors of the whole run, it must be called once with the issues of all the linters.
+func (pr *processing) processRun(inIssues []result.Issue) []result.Issue {
+	if len(inIssues) == 0 {
+		return nil
 	}
-	r.printPerProcessorStat(statPerProcessor)
-	sw.PrintStages()
+
+	outIssues := pr.runner.processIssues(inIssues, pr.runner.Processors[pr.runner.runProcessorsFrom:], pr.sw, pr.statPerProcessor)
+	pr.issuesAfter += len(outIssues)
 
 	return outIssues
 }
 
+// processStream runs the stream processors on a copy of the issues of a linter:
+// the statistics and the hidden issues are not recorded.
+func (pr *processing) processStream(inIssues []result.Issue) []result.Issue {
+	if len(inIssues) == 0 {
+		return nil
+	}
+
+	return pr.runner.processIssues(slices.Clone(inIssues), pr.runner.streamProcessors, pr.sw, nil)
+}
+
+func (pr *processing) finish() {
+	// finalize processors: logging, clearing, no heavy work here
+
+	for _, p := range pr.runner.Processors {
+		pr.sw.TrackStage(p.Name(), p.Finish)
+	}
+
+	if pr.issuesBefore != pr.issuesAfter {
+		pr.runner.Log.Infof("Issues before processing: %d, after processing: %d", pr.issuesBefore, pr.issuesAfter)
+	}
+	pr.runner.printPerProcessorStat(pr.statPerProcessor)
+	pr.sw.PrintStages()
+}
+
 func (r *Runner) printPerProcessorStat(stat map[string]processorStat) {
 	parts := make([]string, 0, len(stat))
 	for name, ps := range stat {
@@ -212,19 +415,32 @@ func (r *Runner) printPerProcessorStat(stat map[string]processorStat) {
 	}
 }
 
-func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat) []result.Issue {
-	for _, p := range r.Processors {
+// processIssues runs the processors, the statistics and the hidden issues are only recorded if statPerProcessor is not nil.
+func (r *Runner) processIssues(issues []result.Issue, procs []processors.Processor,
+	sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat,
+) []result.Issue {
+	for _, p := range procs {
 		newIssues, err := timeutils.TrackStage(sw, p.Name(), func() ([]result.Issue, error) {
 			return p.Process(issues)
 		})
 
-		if err != nil {
+		switch {
+		case err != nil:
 			r.Log.Warnf("Can't process result by %s processor: %s", p.Name(), err)
-		} else {
+
+		case statPerProcessor == nil:
+			issues = newIssues
+
+		default:
 			stat := statPerProcessor[p.Name()]
 			stat.inCount += len(issues)
 			stat.outCount += len(newIssues)
 			statPerProcessor[p.Name()] = stat
+
+			if r.explainHidden {
+				r.hiddenIssues = append(r.hiddenIssues, collectHiddenIssues(p.Name(), issues)...)
+			}
+
 			issues = newIssues
 		}
 
@@ -236,3 +452,54 @@ func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, s
 
 	return issues
 }
+
+// isPartialRun reports whether the run is limited to some packages or to the new issues.
+func isPartialRun(cfg *config.Config, args []string) bool {
+	if cfg.Issues.Diff || cfg.Issues.DiffFromRevision != "" || cfg.Issues.DiffPatchFilePath != "" {
+		return true
+	}
+
+	for _, arg := range args {
+		if arg != "./..." {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinExpiredRules(rules []config.ExpiringRule) string {
+	var parts []string
+	for _, rule := range rules {
+		parts = append(parts, rule.String())
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// collectHiddenIssues returns the issues marked as hidden by the processor.
+// The issues hidden without explanation (ex: the issues about the nolint directives used to detect the unused ones)
+// are ignored.
+func collectHiddenIssues(processorName string, issues []result.Issue) []result.HiddenIssue {
+	var hidden []result.HiddenIssue
+
+	for i := range issues {
+		by := issues[i].HiddenBy
+		if by == nil {
+			continue
+		}
+
+		issue := issues[i]
+		issue.HiddenBy = nil
+
+		hidden = append(hidden, result.HiddenIssue{
+			Issue:     issue,
+			Processor: processorName,
+			Kind:      by.Kind,
+			Name:      by.Name,
+			Reason:    by.Reason,
+		})
+	}
+
+	return hidden
+}
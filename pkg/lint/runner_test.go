@@ -0,0 +1,301 @@
+package lint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// fakeLinter reports predefined issues.
+type fakeLinter struct {
+	name   string
+	issues []result.Issue
+}
+
+func (l fakeLinter) Run(_ context.Context, _ *linter.Context) ([]result.Issue, error) {
+	return l.issues, nil
+}
+
+func (l fakeLinter) Name() string { return l.name }
+
+func (fakeLinter) Desc() string { return "fake linter" }
+
+func newFakeIssue(ruleID string, line int) result.Issue {
+	return result.Issue{
+		RuleID: ruleID,
+		Text:   "Error return value is not checked",
+		Pos:    token.Position{Filename: "runner.go", Line: line},
+	}
+}
+
+func newTestRunner(t *testing.T, cfg *config.Config) *Runner {
+	t.Helper()
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	fileCache := fsutils.NewFileCache()
+
+	dbManager, err := lintersdb.NewManager(log, cfg)
+	require.NoError(t, err)
+
+	runner, err := NewRunner(log, cfg, []string{"./..."}, goutil.NewEnv(log),
+		fsutils.NewLineCache(fileCache), fileCache, dbManager, &linter.Context{})
+	require.NoError(t, err)
+
+	return runner
+}
+
+func TestRunner_Run_equivalentIssuesWithSeverityRules(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.InternalTest = true
+	cfg.Issues.MergeEquivalentIssues = true
+	cfg.Severity = config.Severity{
+		Default: "warning",
+		Rules: []config.SeverityRule{
+			{Severity: "error", BaseRule: config.BaseRule{Linters: []string{"gosec"}}},
+		},
+	}
+
+	runner := newTestRunner(t, cfg)
+
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "errcheck", issues: []result.Issue{newFakeIssue("", 10)}}),
+		linter.NewConfig(fakeLinter{name: "gosec", issues: []result.Issue{newFakeIssue("G104", 10)}}),
+	}
+
+	issues, err := runner.Run(context.Background(), linters)
+	require.NoError(t, err)
+
+	// Without the severity rule, the issue of errcheck would be kept (first rule of the group).
+	require.Len(t, issues, 1)
+	assert.Equal(t, "gosec", issues[0].FromLinter)
+	assert.Equal(t, "error", issues[0].Severity)
+
+	require.Len(t, issues[0].Aliases, 1)
+	assert.Equal(t, result.Alias{FromLinter: "errcheck", Text: "Error return value is not checked", Severity: "warning"}, issues[0].Aliases[0])
+}
+
+func TestRunner_Run_failOnUnusedRules(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.InternalTest = true
+	cfg.Issues.FailOnUnusedRules = true
+	cfg.Severity = config.Severity{
+		Default: "warning",
+		Rules: []config.SeverityRule{
+			{Severity: "error", BaseRule: config.BaseRule{Text: "unknown"}},
+		},
+	}
+
+	runner := newTestRunner(t, cfg)
+
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "errcheck", issues: []result.Issue{newFakeIssue("", 10)}}),
+	}
+
+	// The issues are returned: the unused rules only change the exit code.
+	issues, err := runner.Run(context.Background(), linters)
+	require.NoError(t, err)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "warning", issues[0].Severity)
+
+	assert.Equal(t, []string{"severity.rules[0]"}, runner.UnusedRules())
+}
+
+func TestRunner_Run_unusedRulesWithLimits(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.InternalTest = true
+	cfg.Output.UniqByLine = true
+	cfg.Severity = config.Severity{
+		Default: "warning",
+		Rules: []config.SeverityRule{
+			{Severity: "error", BaseRule: config.BaseRule{Text: "^second$"}},
+		},
+	}
+
+	runner := newTestRunner(t, cfg)
+
+	first := newFakeIssue("", 10)
+	first.Text = "first"
+
+	second := newFakeIssue("", 10)
+	second.Text = "second"
+
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "lintera", issues: []result.Issue{first}}),
+		linter.NewConfig(fakeLinter{name: "linterb", issues: []result.Issue{second}}),
+	}
+
+	issues, err := runner.Run(context.Background(), linters)
+	require.NoError(t, err)
+
+	// The issue matching the severity rule is dropped by uniq-by-line, but the rule is used.
+	require.Len(t, issues, 1)
+	assert.Equal(t, "lintera", issues[0].FromLinter)
+
+	assert.Empty(t, runner.UnusedRules())
+}
+
+func Test_isPartialRun(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		issues   config.Issues
+		args     []string
+		expected bool
+	}{
+		{
+			desc: "no arguments",
+		},
+		{
+			desc: "all the packages",
+			args: []string{"./..."},
+		},
+		{
+			desc:     "some packages",
+			args:     []string{"./pkg/report/"},
+			expected: true,
+		},
+		{
+			desc:     "new issues",
+			issues:   config.Issues{Diff: true},
+			expected: true,
+		},
+		{
+			desc:     "new from revision",
+			issues:   config.Issues{DiffFromRevision: "HEAD~1"},
+			args:     []string{"./..."},
+			expected: true,
+		},
+		{
+			desc:     "new from patch",
+			issues:   config.Issues{DiffPatchFilePath: "changes.patch"},
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{Issues: test.issues}
+
+			assert.Equal(t, test.expected, isPartialRun(cfg, test.args))
+		})
+	}
+}
+
+func TestRunner_Run_stream(t *testing.T) {
+	outputDir := t.TempDir()
+
+	cfg := config.NewDefault()
+	cfg.InternalTest = true
+	cfg.Issues.MergeEquivalentIssues = true
+	cfg.Output.SortResults = true
+	cfg.Output.Formats = config.OutputFormats{
+		{Format: config.OutFormatNDJSON, Path: filepath.Join(outputDir, "report.ndjson")},
+		{Format: config.OutFormatJSON, Path: filepath.Join(outputDir, "report.json")},
+	}
+
+	runner := newTestRunner(t, cfg)
+
+	printer, err := printers.NewPrinter(logutils.NewStderrLog(logutils.DebugKeyEmpty), &cfg.Output, &report.Data{})
+	require.NoError(t, err)
+
+	runner.SetIssuesHandler(printer.Stream)
+
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "misspell", issues: []result.Issue{newFakeIssue("", 30), newFakeIssue("", 5)}}),
+		linter.NewConfig(fakeLinter{name: "gosec", issues: []result.Issue{newFakeIssue("G104", 10)}}),
+		linter.NewConfig(fakeLinter{name: "errcheck", issues: []result.Issue{newFakeIssue("", 10)}}),
+	}
+
+	issues, err := runner.Run(context.Background(), linters)
+	require.NoError(t, err)
+
+	err = printer.Print(issues)
+	require.NoError(t, err)
+
+	// The issues of the whole run are sorted, and the equivalent issues of several linters are merged.
+	content, err := os.ReadFile(filepath.Join(outputDir, "report.json"))
+	require.NoError(t, err)
+
+	var res printers.JSONResult
+	err = json.Unmarshal(content, &res)
+	require.NoError(t, err)
+
+	require.Len(t, res.Issues, 3)
+
+	assert.Equal(t, issuePositions{{"misspell", 5}, {"errcheck", 10}, {"misspell", 30}}, newIssuePositions(res.Issues))
+
+	require.Len(t, res.Issues[1].Aliases, 1)
+	assert.Equal(t, "gosec", res.Issues[1].Aliases[0].FromLinter)
+
+	// The streamed issues are processed linter by linter: the equivalent issues of several linters are not merged.
+	content, err = os.ReadFile(filepath.Join(outputDir, "report.ndjson"))
+	require.NoError(t, err)
+
+	var streamed []result.Issue
+
+	var issuesCount, issueRecordsCount int
+
+	for _, line := range bytes.Split(bytes.TrimSpace(content), []byte("\n")) {
+		var record struct {
+			Type              string
+			Issue             *result.Issue
+			IssuesCount       int
+			IssueRecordsCount int
+		}
+
+		err = json.Unmarshal(line, &record)
+		require.NoError(t, err)
+
+		if record.Issue != nil {
+			streamed = append(streamed, *record.Issue)
+		}
+
+		if record.Type == "summary" {
+			issuesCount, issueRecordsCount = record.IssuesCount, record.IssueRecordsCount
+		}
+	}
+
+	assert.Equal(t, issuePositions{{"misspell", 5}, {"misspell", 30}, {"gosec", 10}, {"errcheck", 10}}, newIssuePositions(streamed))
+
+	// The summary counts the issues of the final result, like the other outputs.
+	assert.Equal(t, len(res.Issues), issuesCount)
+	assert.Equal(t, len(streamed), issueRecordsCount)
+}
+
+type issuePositions []struct {
+	linter string
+	line   int
+}
+
+func newIssuePositions(issues []result.Issue) issuePositions {
+	var positions issuePositions
+
+	for i := range issues {
+		positions = append(positions, struct {
+			linter string
+			line   int
+		}{issues[i].FromLinter, issues[i].Line()})
+	}
+
+	return positions
+}
@@ -0,0 +1,49 @@
+package printers
+
+import (
+	"slices"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+// filterIssues applies the filters and the sort order of an output.
+// The issues are copied, so the other outputs are not affected.
+func filterIssues(issues []result.Issue, format *config.OutputFormat) ([]result.Issue, error) {
+	if !hasFilters(format) && len(format.SortOrder) == 0 {
+		return issues, nil
+	}
+
+	minRank := config.SeverityRank(format.MinSeverity)
+
+	filtered := make([]result.Issue, 0, len(issues))
+
+	for i := range issues {
+		issue := &issues[i]
+
+		switch {
+		case config.SeverityRank(issue.Severity) < minRank,
+			len(format.Linters) > 0 && !slices.Contains(format.Linters, issue.FromLinter),
+			slices.Contains(format.ExcludeLinters, issue.FromLinter),
+			format.OnlyNew && !issue.IsNew:
+			continue
+		}
+
+		filtered = append(filtered, *issue)
+	}
+
+	if len(format.SortOrder) == 0 {
+		return filtered, nil
+	}
+
+	sorter := processors.NewSortResults(&config.Config{
+		Output: config.Output{SortResults: true, SortOrder: format.SortOrder},
+	})
+
+	return sorter.Process(filtered)
+}
+
+func hasFilters(format *config.OutputFormat) bool {
+	return format.MinSeverity != "" || len(format.Linters) > 0 || len(format.ExcludeLinters) > 0 || format.OnlyNew
+}
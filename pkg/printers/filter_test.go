@@ -0,0 +1,94 @@
+package printers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func Test_filterIssues(t *testing.T) {
+	newIssue := func(linter, severity string, isNew bool) result.Issue {
+		return result.Issue{FromLinter: linter, Severity: severity, IsNew: isNew}
+	}
+
+	issues := []result.Issue{
+		newIssue("linter-a", "low", false),
+		newIssue("linter-b", "error", true),
+		newIssue("linter-a", "warning", true),
+		newIssue("linter-c", "", false),
+	}
+
+	testCases := []struct {
+		desc     string
+		format   *config.OutputFormat
+		expected []result.Issue
+	}{
+		{
+			desc:     "no filters",
+			format:   &config.OutputFormat{},
+			expected: issues,
+		},
+		{
+			desc:   "min-severity",
+			format: &config.OutputFormat{MinSeverity: "warning"},
+			expected: []result.Issue{
+				newIssue("linter-b", "error", true),
+				newIssue("linter-a", "warning", true),
+			},
+		},
+		{
+			desc:   "linters",
+			format: &config.OutputFormat{Linters: []string{"linter-a", "linter-c"}},
+			expected: []result.Issue{
+				newIssue("linter-a", "low", false),
+				newIssue("linter-a", "warning", true),
+				newIssue("linter-c", "", false),
+			},
+		},
+		{
+			desc:   "exclude-linters",
+			format: &config.OutputFormat{ExcludeLinters: []string{"linter-a"}},
+			expected: []result.Issue{
+				newIssue("linter-b", "error", true),
+				newIssue("linter-c", "", false),
+			},
+		},
+		{
+			desc:   "only-new",
+			format: &config.OutputFormat{OnlyNew: true},
+			expected: []result.Issue{
+				newIssue("linter-b", "error", true),
+				newIssue("linter-a", "warning", true),
+			},
+		},
+		{
+			desc:   "sort-order",
+			format: &config.OutputFormat{ExcludeLinters: []string{"linter-c"}, SortOrder: []string{"linter", "severity"}},
+			expected: []result.Issue{
+				newIssue("linter-a", "low", false),
+				newIssue("linter-a", "warning", true),
+				newIssue("linter-b", "error", true),
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			original := append([]result.Issue{}, issues...)
+
+			filtered, err := filterIssues(issues, test.format)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, filtered)
+
+			// The issues of the other outputs are not modified.
+			assert.Equal(t, original, issues)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package printers
 
 import (
+	"embed"
 	"fmt"
 	"html/template"
 	"io"
@@ -9,117 +10,30 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-const templateContent = `<!doctype html>
-<html lang="en">
-<head>
-    <meta charset="utf-8">
-    <title>golangci-lint</title>
-    <link rel="shortcut icon" type="image/png" href="https://golangci-lint.run/favicon-32x32.png">
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/bulma/0.9.2/css/bulma.min.css"
-          integrity="sha512-byErQdWdTqREz6DLAA9pCnLbdoGGhXfU6gm1c8bkf7F51JVmUBlayGe2A31VpXWQP+eiJ3ilTAZHCR3vmMyybA=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/styles/default.min.css"
-          integrity="sha512-kZqGbhf9JTB4bVJ0G8HCkqmaPcRgo88F0dneK30yku5Y/dep7CZfCnNml2Je/sY4lBoqoksXz4PtVXS4GHSUzQ=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/highlight.min.js"
-            integrity="sha512-s+tOYYcC3Jybgr9mVsdAxsRYlGNq4mlAurOrfNuGMQ/SCofNPu92tjE7YRZCsdEtWL1yGkqk15fU/ark206YTg=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/languages/go.min.js"
-            integrity="sha512-+UYV2NyyynWEQcZ4sMTKmeppyV331gqvMOGZ61/dqc89Tn1H40lF05ACd03RSD9EWwGutNwKj256mIR8waEJBQ=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/react/17.0.2/umd/react.production.min.js"
-            integrity="sha512-qlzIeUtTg7eBpmEaS12NZgxz52YYZVF5myj89mjJEesBd/oE9UPsYOX2QAXzvOAZYEvQohKdcY8zKE02ifXDmA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript"
-            src="https://cdnjs.cloudflare.com/ajax/libs/react-dom/17.0.2/umd/react-dom.production.min.js"
-            integrity="sha512-9jGNr5Piwe8nzLLYTk8QrEMPfjGU0px80GYzKZUxi7lmCfrBjtyCc1V5kkS5vxVwwIB7Qpzc7UxLiQxfAN30dw=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/babel-standalone/6.26.0/babel.min.js"
-            integrity="sha512-kp7YHLxuJDJcOzStgd6vtpxr4ZU9kjn77e6dBsivSz+pUuAuMlE2UTdKB7jjsWT84qbS8kdCWHPETnP/ctrFsA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-</head>
-<body>
-<section class="section">
-    <div class="container">
-        <div id="content"></div>
-    </div>
-</section>
-<script>
-    const data = {{ . }};
-</script>
-<script type="text/babel">
-  class Highlight extends React.Component {
-    componentDidMount() {
-      hljs.highlightElement(ReactDOM.findDOMNode(this));
-    }
-
-    render() {
-      return <pre className="go"><code>{this.props.code}</code></pre>;
-    }
-  }
-
-  class Issue extends React.Component {
-    render() {
-      return (
-        <div className="issue box">
-          <div>
-            <div className="columns">
-              <div className="column is-four-fifths">
-                <h5 className="title is-5 has-text-danger-dark">{this.props.data.Title}</h5>
-              </div>
-              <div className="column is-one-fifth">
-                <h6 className="title is-6">{this.props.data.Linter}</h6>
-              </div>
-            </div>
-            <strong>{this.props.data.Pos}</strong>
-          </div>
-          <div className="highlight">
-            <Highlight code={this.props.data.Code}/>
-          </div>
-        </div>
-      );
-    }
-  }
-
-  class Issues extends React.Component {
-    render() {
-      if (!this.props.data.Issues || this.props.data.Issues.length === 0) {
-        return (
-          <div>
-            <div className="notification">
-              No issues found!
-            </div>
-          </div>
-        );
-      }
-
-      return (
-        <div className="issues">
-          {this.props.data.Issues.map(issue => (<Issue data={issue}/>))}
-        </div>
-      );
-    }
-  }
-
-  ReactDOM.render(
-    <div className="content">
-      <div className="columns is-centered">
-        <div className="column is-three-quarters">
-          <Issues data={data}/>
-        </div>
-      </div>
-    </div>,
-    document.getElementById("content")
-  );
-</script>
-</body>
-</html>`
+// The assets are embedded to produce a self-contained report (no network access is needed to display it).
+//
+//go:embed html
+var htmlAssets embed.FS
 
 type htmlIssue struct {
-	Title  string
-	Pos    string
-	Linter string
-	Code   string
+	Title    string
+	Pos      string
+	File     string
+	Line     int
+	Linter   string
+	Severity string
+
+	// FirstLine is the line number of the first source line.
+	FirstLine   int
+	SourceLines []string
+
+	Fix *htmlFix `json:",omitempty"`
+}
+
+// htmlFix is the preview of the replacement of the source lines.
+type htmlFix struct {
+	Removed []string
+	Added   []string
 }
 
 type HTML struct {
@@ -131,7 +45,7 @@ func NewHTML(w io.Writer) *HTML {
 }
 
 func (p HTML) Print(issues []result.Issue) error {
-	var htmlIssues []htmlIssue
+	htmlIssues := make([]htmlIssue, 0, len(issues))
 
 	for i := range issues {
 		pos := fmt.Sprintf("%s:%d", issues[i].FilePath(), issues[i].Line())
@@ -140,17 +54,76 @@ func (p HTML) Print(issues []result.Issue) error {
 		}
 
 		htmlIssues = append(htmlIssues, htmlIssue{
-			Title:  strings.TrimSpace(issues[i].Text),
-			Pos:    pos,
-			Linter: issues[i].FromLinter,
-			Code:   strings.Join(issues[i].SourceLines, "\n"),
+			Title:       strings.TrimSpace(issues[i].Text),
+			Pos:         pos,
+			File:        issues[i].FilePath(),
+			Line:        issues[i].Line(),
+			Linter:      issues[i].FromLinter,
+			Severity:    issues[i].Severity,
+			FirstLine:   issues[i].GetLineRange().From,
+			SourceLines: issues[i].SourceLines,
+			Fix:         newHTMLFix(&issues[i]),
 		})
 	}
 
-	t, err := template.New("golangci-lint").Parse(templateContent)
+	t, err := template.ParseFS(htmlAssets, "html/report.gohtml")
+	if err != nil {
+		return err
+	}
+
+	css, err := htmlAssets.ReadFile("html/report.css")
 	if err != nil {
 		return err
 	}
 
-	return t.Execute(p.w, struct{ Issues []htmlIssue }{Issues: htmlIssues})
+	js, err := htmlAssets.ReadFile("html/report.js")
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(p.w, struct {
+		CSS  template.CSS
+		JS   template.JS
+		Data struct{ Issues []htmlIssue }
+	}{
+		CSS:  template.CSS(css), //nolint:gosec // embedded asset
+		JS:   template.JS(js),   //nolint:gosec // embedded asset
+		Data: struct{ Issues []htmlIssue }{Issues: htmlIssues},
+	})
+}
+
+// newHTMLFix builds the preview of the fix of the issue, or nil if the issue can't be fixed.
+func newHTMLFix(issue *result.Issue) *htmlFix {
+	if issue.Replacement == nil || len(issue.SourceLines) == 0 {
+		return nil
+	}
+
+	fix := &htmlFix{Removed: issue.SourceLines}
+
+	switch {
+	case issue.Replacement.NeedOnlyDelete:
+	case issue.Replacement.Inline != nil:
+		inline := issue.Replacement.Inline
+		line := issue.SourceLines[0]
+
+		if inline.StartCol < 0 || inline.StartCol+inline.Length > len(line) {
+			return nil
+		}
+
+		fix.Removed = issue.SourceLines[:1]
+		fix.Added = []string{line[:inline.StartCol] + inline.NewString + line[inline.StartCol+inline.Length:]}
+	default:
+		fix.Added = issue.Replacement.NewLines
+	}
+
+	// Only the modified lines are displayed.
+	for len(fix.Removed) > 0 && len(fix.Added) > 0 && fix.Removed[0] == fix.Added[0] {
+		fix.Removed, fix.Added = fix.Removed[1:], fix.Added[1:]
+	}
+
+	for len(fix.Removed) > 0 && len(fix.Added) > 0 && fix.Removed[len(fix.Removed)-1] == fix.Added[len(fix.Added)-1] {
+		fix.Removed, fix.Added = fix.Removed[:len(fix.Removed)-1], fix.Added[:len(fix.Added)-1]
+	}
+
+	return fix
 }
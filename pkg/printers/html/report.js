@@ -0,0 +1,194 @@
+(function () {
+  "use strict";
+
+  const keywords = new Set([
+    "break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for", "func", "go", "goto",
+    "if", "import", "interface", "map", "package", "range", "return", "select", "struct", "switch", "type", "var",
+  ]);
+
+  const builtins = new Set([
+    "any", "append", "bool", "byte", "cap", "clear", "close", "comparable", "complex", "complex64", "complex128", "copy",
+    "delete", "error", "false", "float32", "float64", "imag", "int", "int8", "int16", "int32", "int64", "iota", "len",
+    "make", "max", "min", "new", "nil", "panic", "print", "println", "real", "recover", "rune", "string", "true", "uint",
+    "uint8", "uint16", "uint32", "uint64", "uintptr",
+  ]);
+
+  const tokenPattern = /(\/\/.*$|\/\*.*?\*\/)|("(?:[^"\\]|\\.)*"?|`[^`]*`?|'(?:[^'\\]|\\.)*'?)|(\b\d[\d_.xXa-fA-FoObBeEpP]*\b)|([A-Za-z_]\w*)/g;
+
+  function el(tag, className, text) {
+    const node = document.createElement(tag);
+    if (className) {
+      node.className = className;
+    }
+    if (text !== undefined) {
+      node.textContent = text;
+    }
+    return node;
+  }
+
+  // highlight appends the tokens of a line of Go code to the node.
+  function highlight(node, code) {
+    let last = 0;
+
+    for (const match of code.matchAll(tokenPattern)) {
+      if (match.index > last) {
+        node.appendChild(document.createTextNode(code.slice(last, match.index)));
+      }
+
+      let className = "";
+      if (match[1]) {
+        className = "tok-comment";
+      } else if (match[2]) {
+        className = "tok-string";
+      } else if (match[3]) {
+        className = "tok-number";
+      } else if (keywords.has(match[4])) {
+        className = "tok-keyword";
+      } else if (builtins.has(match[4])) {
+        className = "tok-builtin";
+      }
+
+      node.appendChild(className ? el("span", className, match[0]) : document.createTextNode(match[0]));
+      last = match.index + match[0].length;
+    }
+
+    if (last < code.length) {
+      node.appendChild(document.createTextNode(code.slice(last)));
+    }
+  }
+
+  function codeLine(className, num, code) {
+    const line = el("span", "line" + (className ? " " + className : ""));
+    line.appendChild(el("span", "num", num));
+    highlight(line, code);
+    return line;
+  }
+
+  function renderSource(issue) {
+    const pre = el("pre");
+
+    issue.SourceLines.forEach((code, i) => {
+      const num = issue.FirstLine + i;
+      pre.appendChild(codeLine(num === issue.Line ? "issued" : "", String(num), code));
+    });
+
+    return pre;
+  }
+
+  function renderFix(fix) {
+    const pre = el("pre");
+
+    fix.Removed.forEach((code) => pre.appendChild(codeLine("removed", "-", code)));
+    fix.Added.forEach((code) => pre.appendChild(codeLine("added", "+", code)));
+
+    return pre;
+  }
+
+  function renderIssue(issue) {
+    const node = el("div", "issue");
+
+    const header = el("div");
+    header.appendChild(el("span", "title", issue.Title));
+    header.appendChild(el("span", "badge", issue.Linter));
+    if (issue.Severity) {
+      header.appendChild(el("span", "badge severity", issue.Severity));
+    }
+    node.appendChild(header);
+
+    node.appendChild(el("div", "pos", issue.Pos));
+
+    if (issue.SourceLines && issue.SourceLines.length > 0) {
+      node.appendChild(renderSource(issue));
+    }
+
+    if (issue.Fix) {
+      node.appendChild(el("div", "fix-title", "Suggested fix"));
+      node.appendChild(renderFix(issue.Fix));
+    }
+
+    return node;
+  }
+
+  function countBy(issues, key) {
+    const counts = new Map();
+    issues.forEach((issue) => counts.set(issue[key], (counts.get(issue[key]) || 0) + 1));
+    return new Map([...counts.entries()].sort((a, b) => b[1] - a[1] || String(a[0]).localeCompare(String(b[0]))));
+  }
+
+  function renderStat(title, value, counts) {
+    const node = el("div", "stat");
+    node.appendChild(el("span", "", title));
+    node.appendChild(el("strong", "", String(value)));
+
+    if (counts) {
+      const list = el("ul");
+      counts.forEach((count, name) => list.appendChild(el("li", "", (name || "none") + ": " + count)));
+      node.appendChild(list);
+    }
+
+    return node;
+  }
+
+  function renderStats(issues) {
+    const stats = document.getElementById("stats");
+    stats.replaceChildren(
+      renderStat("Issues", issues.length),
+      renderStat("Files", countBy(issues, "File").size),
+      renderStat("Linters", countBy(issues, "Linter").size, countBy(issues, "Linter")),
+      renderStat("Severities", countBy(issues, "Severity").size, countBy(issues, "Severity")),
+    );
+  }
+
+  function fillSelect(id, values) {
+    const select = document.getElementById(id);
+    [...new Set(values)].filter((v) => v).sort().forEach((v) => select.appendChild(el("option", "", v)));
+  }
+
+  function render() {
+    const linter = document.getElementById("filter-linter").value;
+    const severity = document.getElementById("filter-severity").value;
+    const path = document.getElementById("filter-path").value;
+    const groupBy = document.getElementById("group-by").value === "linter" ? "Linter" : "File";
+
+    const issues = (data.Issues || []).filter((issue) =>
+      (!linter || issue.Linter === linter) &&
+      (!severity || issue.Severity === severity) &&
+      (!path || issue.File.includes(path)));
+
+    renderStats(issues);
+
+    const content = document.getElementById("content");
+
+    if (issues.length === 0) {
+      content.replaceChildren(el("div", "empty", "No issues found!"));
+      return;
+    }
+
+    const groups = new Map();
+    issues.forEach((issue) => {
+      const key = issue[groupBy];
+      if (!groups.has(key)) {
+        groups.set(key, []);
+      }
+      groups.get(key).push(issue);
+    });
+
+    const nodes = [...groups.keys()].sort().map((key) => {
+      const group = el("details", "group");
+      group.open = true;
+      group.appendChild(el("summary", "", key + " (" + groups.get(key).length + ")"));
+      groups.get(key).forEach((issue) => group.appendChild(renderIssue(issue)));
+      return group;
+    });
+
+    content.replaceChildren(...nodes);
+  }
+
+  fillSelect("filter-linter", (data.Issues || []).map((issue) => issue.Linter));
+  fillSelect("filter-severity", (data.Issues || []).map((issue) => issue.Severity));
+
+  ["filter-linter", "filter-severity", "filter-path", "group-by"].forEach((id) =>
+    document.getElementById(id).addEventListener("input", render));
+
+  render();
+})();
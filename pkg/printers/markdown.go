@@ -0,0 +1,248 @@
+package printers
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// defaultMarkdownMaxSize is the maximum size of a GitHub comment (65536 characters).
+// It can be increased with `output.markdown.max-size`, e.g. up to 1 MiB for $GITHUB_STEP_SUMMARY.
+const defaultMarkdownMaxSize = 65536
+
+// markdownTruncationReserve is the space kept at the end of the report to explain the truncation.
+const markdownTruncationReserve = 256
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"|", `\|`,
+	"<", "&lt;",
+	">", "&gt;",
+	"\n", " ",
+)
+
+type markdownFile struct {
+	path   string
+	issues []*result.Issue
+}
+
+// Markdown prints a summary of the issues for the pull request comments and the job summaries:
+// a table of the issues by linter and severity, followed by a collapsible section for each file.
+// The output is truncated to fit in maxSize bytes.
+type Markdown struct {
+	rd      *report.Data
+	maxSize int
+	w       io.Writer
+}
+
+func NewMarkdown(rd *report.Data, maxSize int, w io.Writer) *Markdown {
+	return &Markdown{
+		rd:      rd,
+		maxSize: maxSize,
+		w:       w,
+	}
+}
+
+func (p Markdown) Print(issues []result.Issue) error {
+	buf := new(bytes.Buffer)
+
+	buf.WriteString("## golangci-lint\n\n")
+
+	if len(issues) == 0 {
+		buf.WriteString("No issues found.\n")
+
+		_, err := p.w.Write(buf.Bytes())
+		return err
+	}
+
+	files := groupMarkdownFiles(issues)
+
+	fmt.Fprintf(buf, "%s in %s.\n\n", plural(len(issues), "issue"), plural(len(files), "file"))
+
+	p.writeSummary(buf, issues)
+
+	for i, file := range files {
+		section := p.fileSection(file, true)
+
+		if !p.fits(buf, section) {
+			// The snippets are dropped before the whole section.
+			section = p.fileSection(file, false)
+		}
+
+		if !p.fits(buf, section) {
+			writeMarkdownTruncation(buf, files[i:])
+			break
+		}
+
+		buf.Write(section)
+	}
+
+	_, err := p.w.Write(buf.Bytes())
+
+	return err
+}
+
+func (p Markdown) fits(buf *bytes.Buffer, section []byte) bool {
+	return p.maxSize <= 0 || buf.Len()+len(section)+markdownTruncationReserve <= p.maxSize
+}
+
+func (p Markdown) writeSummary(buf *bytes.Buffer, issues []result.Issue) {
+	type key struct{ linter, severity string }
+
+	counts := map[key]int{}
+
+	for i := range issues {
+		counts[key{linter: issues[i].FromLinter, severity: issues[i].Severity}]++
+	}
+
+	keys := make([]key, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+
+	slices.SortFunc(keys, func(a, b key) int {
+		if a.linter != b.linter {
+			return strings.Compare(a.linter, b.linter)
+		}
+
+		return strings.Compare(a.severity, b.severity)
+	})
+
+	buf.WriteString("| Linter | Severity | Issues |\n")
+	buf.WriteString("|--------|----------|-------:|\n")
+
+	for _, k := range keys {
+		severity := k.severity
+		if severity == "" {
+			severity = "-"
+		}
+
+		fmt.Fprintf(buf, "| %s | %s | %d |\n", p.linterLink(k.linter), markdownEscaper.Replace(severity), counts[k])
+	}
+
+	buf.WriteString("\n")
+}
+
+func (p Markdown) fileSection(file markdownFile, withSnippets bool) []byte {
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "<details>\n<summary><code>%s</code> (%s)</summary>\n\n",
+		html.EscapeString(file.path), plural(len(file.issues), "issue"))
+
+	for _, issue := range file.issues {
+		pos := fmt.Sprintf("%d", issue.Line())
+		if issue.Column() != 0 {
+			pos += fmt.Sprintf(":%d", issue.Column())
+		}
+
+		fmt.Fprintf(buf, "- **%s** %s: %s\n", pos, p.linterLink(issue.FromLinter), escapeMarkdownText(strings.TrimSpace(issue.Text)))
+
+		if withSnippets && len(issue.SourceLines) > 0 {
+			writeMarkdownSnippet(buf, file.path, issue.SourceLines)
+		}
+	}
+
+	buf.WriteString("\n</details>\n\n")
+
+	return buf.Bytes()
+}
+
+// writeMarkdownTruncation explains which issues are not displayed.
+func writeMarkdownTruncation(buf *bytes.Buffer, files []markdownFile) {
+	var count int
+	for _, file := range files {
+		count += len(file.issues)
+	}
+
+	fmt.Fprintf(buf, "> [!NOTE]\n> The report is truncated: %s in %s are not displayed.\n",
+		plural(count, "issue"), plural(len(files), "file"))
+}
+
+// linterLink returns the name of the linter, with a link to its documentation when it is known.
+func (p Markdown) linterLink(name string) string {
+	if lc := findLinterData(p.rd, name); lc != nil && lc.URL != "" {
+		return fmt.Sprintf("[%s](%s)", markdownEscaper.Replace(name), lc.URL)
+	}
+
+	return markdownEscaper.Replace(name)
+}
+
+// escapeMarkdownText escapes the text of an issue, but keeps the code spans (between backticks) of the message.
+func escapeMarkdownText(text string) string {
+	parts := strings.Split(text, "`")
+	if len(parts)%2 == 0 {
+		// Unbalanced backticks: there is no code span.
+		return strings.ReplaceAll(markdownEscaper.Replace(text), "`", "\\`")
+	}
+
+	for i := range parts {
+		if i%2 == 0 {
+			parts[i] = markdownEscaper.Replace(parts[i])
+		} else {
+			parts[i] = strings.ReplaceAll(parts[i], "\n", " ")
+		}
+	}
+
+	return strings.Join(parts, "`")
+}
+
+// writeMarkdownSnippet writes the source lines as an indented code block of the list item.
+// The fence is longer than any sequence of backticks inside the code.
+func writeMarkdownSnippet(buf *bytes.Buffer, path string, lines []string) {
+	fence := "```"
+	for strings.Contains(strings.Join(lines, "\n"), fence) {
+		fence += "`"
+	}
+
+	lang := ""
+	if filepath.Ext(path) == ".go" {
+		lang = "go"
+	}
+
+	fmt.Fprintf(buf, "\n  %s%s\n", fence, lang)
+
+	for _, line := range lines {
+		fmt.Fprintf(buf, "  %s\n", line)
+	}
+
+	fmt.Fprintf(buf, "  %s\n", fence)
+}
+
+// groupMarkdownFiles groups the issues by file, in the order of the issues.
+func groupMarkdownFiles(issues []result.Issue) []markdownFile {
+	var files []markdownFile
+
+	indexes := map[string]int{}
+
+	for i := range issues {
+		path := issues[i].FilePath()
+
+		index, ok := indexes[path]
+		if !ok {
+			index = len(files)
+			indexes[path] = index
+			files = append(files, markdownFile{path: path})
+		}
+
+		files[index].issues = append(files[index].issues, &issues[i])
+	}
+
+	return files
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, noun)
+	}
+
+	return fmt.Sprintf("%d %ss", count, noun)
+}
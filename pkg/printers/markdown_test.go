@@ -0,0 +1,172 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMarkdown_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue with <html> and *markdown*",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue",
+			SourceLines: []string{
+				"func foo() {",
+				"\tfmt.Println(\"bar\")",
+				"}",
+			},
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue 2",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   3,
+				Line:     11,
+			},
+		},
+	}
+
+	rd := &report.Data{
+		Linters: []report.LinterData{
+			{Name: "linter-a", URL: "https://example.com/linter-a"},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewMarkdown(rd, defaultMarkdownMaxSize, buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := "## golangci-lint\n\n" +
+		"3 issues in 2 files.\n\n" +
+		"| Linter | Severity | Issues |\n" +
+		"|--------|----------|-------:|\n" +
+		"| [linter-a](https://example.com/linter-a) | warning | 2 |\n" +
+		"| linter-b | error | 1 |\n\n" +
+		"<details>\n<summary><code>path/to/filea.go</code> (2 issues)</summary>\n\n" +
+		"- **10:4** [linter-a](https://example.com/linter-a): some issue with &lt;html&gt; and \\*markdown\\*\n" +
+		"- **11** [linter-a](https://example.com/linter-a): some issue 2\n" +
+		"\n</details>\n\n" +
+		"<details>\n<summary><code>path/to/fileb.go</code> (1 issue)</summary>\n\n" +
+		"- **300:9** linter-b: another issue\n" +
+		"\n  ```go\n  func foo() {\n  \tfmt.Println(\"bar\")\n  }\n  ```\n" +
+		"\n</details>\n\n"
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestMarkdown_Print_empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	printer := NewMarkdown(nil, defaultMarkdownMaxSize, buf)
+
+	err := printer.Print(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "## golangci-lint\n\nNo issues found.\n", buf.String())
+}
+
+func TestMarkdown_Print_truncated(t *testing.T) {
+	var issues []result.Issue
+
+	for _, filename := range []string{"a.go", "b.go", "c.go"} {
+		issues = append(issues, result.Issue{
+			FromLinter:  "linter-a",
+			Text:        "some issue",
+			SourceLines: []string{strings.Repeat("x", 200)},
+			Pos:         token.Position{Filename: filename, Line: 1},
+		})
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewMarkdown(nil, 800, buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	output := buf.String()
+
+	assert.LessOrEqual(t, len(output), 800)
+
+	// The first file is displayed with its snippet, the second one without it.
+	assert.Equal(t, 1, strings.Count(output, strings.Repeat("x", 200)))
+	assert.Contains(t, output, "<code>b.go</code>")
+	assert.NotContains(t, output, "<code>c.go</code>")
+	assert.Contains(t, output, "The report is truncated: 1 issue in 1 file are not displayed.")
+}
+
+func Test_writeMarkdownSnippet_fence(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	writeMarkdownSnippet(buf, "README.md", []string{"```go", "```"})
+
+	assert.Equal(t, "\n  ````\n  ```go\n  ```\n  ````\n", buf.String())
+}
+
+func Test_escapeMarkdownText(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		text     string
+		expected string
+	}{
+		{
+			desc:     "plain",
+			text:     "some issue",
+			expected: "some issue",
+		},
+		{
+			desc:     "markdown and html",
+			text:     "*a* <b> c_d",
+			expected: `\*a\* &lt;b&gt; c\_d`,
+		},
+		{
+			desc:     "code spans",
+			text:     "`*foo` is a misspelling of `foo`",
+			expected: "`*foo` is a misspelling of `foo`",
+		},
+		{
+			desc:     "unbalanced backticks",
+			text:     "`*foo is <bar>",
+			expected: "\\`\\*foo is &lt;bar&gt;",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, escapeMarkdownText(test.text))
+		})
+	}
+}
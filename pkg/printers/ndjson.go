@@ -0,0 +1,83 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// The types of the NDJSON records.
+const (
+	ndjsonTypeIssue   = "issue"
+	ndjsonTypeSummary = "summary"
+)
+
+type ndjsonIssue struct {
+	Type  string
+	Issue *result.Issue
+}
+
+type ndjsonSummary struct {
+	Type string
+	// IssuesCount is the number of issues of the final result.
+	IssuesCount int
+	// IssueRecordsCount is the number of issue records,
+	// the streamed issues are provisional (e.g. the equivalent issues of several linters are not merged).
+	IssueRecordsCount int
+	Report            *report.Data
+}
+
+// NDJSON prints one JSON record per line: a record for each issue, then a summary record with the report data.
+// The issues can be printed while the linters are running (PrintIssues),
+// the summary is printed at the end, with the issues of the final result (PrintSummary).
+type NDJSON struct {
+	rd *report.Data
+	w  io.Writer
+
+	count int
+}
+
+func NewNDJSON(rd *report.Data, w io.Writer) *NDJSON {
+	return &NDJSON{
+		rd: rd,
+		w:  w,
+	}
+}
+
+// PrintIssues prints the records of the issues, without the summary.
+func (p *NDJSON) PrintIssues(issues []result.Issue) error {
+	encoder := json.NewEncoder(p.w)
+
+	for i := range issues {
+		err := encoder.Encode(ndjsonIssue{Type: ndjsonTypeIssue, Issue: &issues[i]})
+		if err != nil {
+			return err
+		}
+
+		p.count++
+	}
+
+	return nil
+}
+
+// Print prints the records of the issues, then the summary.
+func (p *NDJSON) Print(issues []result.Issue) error {
+	err := p.PrintIssues(issues)
+	if err != nil {
+		return err
+	}
+
+	return p.PrintSummary(issues)
+}
+
+// PrintSummary prints the summary, the issues of the final result can differ from the streamed issues.
+func (p *NDJSON) PrintSummary(issues []result.Issue) error {
+	return json.NewEncoder(p.w).Encode(ndjsonSummary{
+		Type:              ndjsonTypeSummary,
+		IssuesCount:       len(issues),
+		IssueRecordsCount: p.count,
+		Report:            p.rd,
+	})
+}
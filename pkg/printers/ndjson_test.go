@@ -0,0 +1,112 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestNDJSON_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+	}
+
+	rd := &report.Data{Version: "1.2.3"}
+
+	testCases := []struct {
+		desc  string
+		print func(p *NDJSON) error
+	}{
+		{
+			desc: "at the end",
+			print: func(p *NDJSON) error {
+				return p.Print(issues)
+			},
+		},
+		{
+			desc: "streaming",
+			print: func(p *NDJSON) error {
+				err := p.PrintIssues(issues[:1])
+				if err != nil {
+					return err
+				}
+
+				err = p.PrintIssues(issues[1:])
+				if err != nil {
+					return err
+				}
+
+				return p.PrintSummary(issues)
+			},
+		},
+	}
+
+	expected := `{"Type":"issue","Issue":{"FromLinter":"linter-a","Text":"some issue","Severity":"warning","SourceLines":null,"Replacement":null,"Pos":{"Filename":"path/to/filea.go","Offset":2,"Line":10,"Column":4},"ExpectNoLint":false,"ExpectedNoLintLinter":""}}
+{"Type":"issue","Issue":{"FromLinter":"linter-b","Text":"another issue","Severity":"error","SourceLines":null,"Replacement":null,"Pos":{"Filename":"path/to/fileb.go","Offset":5,"Line":300,"Column":9},"ExpectNoLint":false,"ExpectedNoLintLinter":""}}
+{"Type":"summary","IssuesCount":2,"IssueRecordsCount":2,"Report":{"Version":"1.2.3"}}
+`
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			buf := new(bytes.Buffer)
+
+			err := test.print(NewNDJSON(rd, buf))
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, buf.String())
+		})
+	}
+}
+
+func TestNDJSON_PrintSummary(t *testing.T) {
+	issues := []result.Issue{
+		{FromLinter: "linter-a", Text: "some issue", Pos: token.Position{Filename: "path/to/filea.go", Line: 10}},
+		{FromLinter: "linter-b", Text: "some issue", Pos: token.Position{Filename: "path/to/filea.go", Line: 10}},
+	}
+
+	buf := new(bytes.Buffer)
+
+	p := NewNDJSON(&report.Data{Version: "1.2.3"}, buf)
+
+	err := p.PrintIssues(issues)
+	require.NoError(t, err)
+
+	// The streamed issues are provisional: the final result only keeps one issue per line.
+	err = p.PrintSummary(issues[:1])
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+
+	assert.JSONEq(t, `{"Type":"summary","IssuesCount":1,"IssueRecordsCount":2,"Report":{"Version":"1.2.3"}}`, lines[2])
+}
@@ -1,11 +1,13 @@
 package printers
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"text/template"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/logutils"
@@ -19,6 +21,20 @@ type issuePrinter interface {
 	Print(issues []result.Issue) error
 }
 
+// streamPrinter is implemented by the printers that can print the issues while the linters are running.
+// The issues printed by PrintIssues are provisional, PrintSummary ends the output with the issues of the final result.
+type streamPrinter interface {
+	issuePrinter
+	PrintIssues(issues []result.Issue) error
+	PrintSummary(issues []result.Issue) error
+}
+
+// stream is an output opened while the linters are running.
+type stream struct {
+	p     streamPrinter
+	close func()
+}
+
 // Printer prints issues
 type Printer struct {
 	cfg        *config.Output
@@ -28,6 +44,12 @@ type Printer struct {
 
 	stdOut io.Writer
 	stdErr io.Writer
+
+	// streams are the opened streaming outputs, by index of the format.
+	streams map[int]*stream
+
+	// templates are the parsed templates of the template outputs, by path of the template.
+	templates map[string]*template.Template
 }
 
 // NewPrinter creates a new Printer.
@@ -42,19 +64,76 @@ func NewPrinter(log logutils.Log, cfg *config.Output, reportData *report.Data) (
 		return nil, errors.New("missing reportData argument in constructor")
 	}
 
+	// The templates are parsed before the creation of the outputs: an invalid template must not truncate an output file.
+	templates, err := parseTemplates(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Printer{
 		cfg:        cfg,
 		reportData: reportData,
 		log:        log,
 		stdOut:     logutils.StdOut,
 		stdErr:     logutils.StdErr,
+		streams:    map[int]*stream{},
+		templates:  templates,
 	}, nil
 }
 
+// CanStream returns true if an output can print the issues while the linters are running.
+func (c *Printer) CanStream() bool {
+	for i := range c.cfg.Formats {
+		if c.cfg.Formats[i].Format == config.OutFormatNDJSON {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Stream prints the issues to the streaming outputs, the other outputs print the issues at the end (Print).
+func (c *Printer) Stream(issues []result.Issue) error {
+	for i := range c.cfg.Formats {
+		format := &c.cfg.Formats[i]
+
+		if format.Format != config.OutFormatNDJSON {
+			continue
+		}
+
+		s, err := c.openStream(i)
+		if err != nil {
+			return err
+		}
+
+		filtered, err := filterIssues(issues, format)
+		if err != nil {
+			return fmt.Errorf("can't filter issues for %s: %w", format.Format, err)
+		}
+
+		if err = s.p.PrintIssues(filtered); err != nil {
+			return fmt.Errorf("can't print %d issues: %w", len(filtered), err)
+		}
+	}
+
+	return nil
+}
+
 // Print prints issues based on the formats defined
 func (c *Printer) Print(issues []result.Issue) error {
-	for _, format := range c.cfg.Formats {
-		err := c.printReports(issues, format)
+	for i := range c.cfg.Formats {
+		if s, ok := c.streams[i]; ok {
+			err := c.printStreamSummary(s, issues, &c.cfg.Formats[i])
+			s.close()
+
+			if err != nil {
+				return fmt.Errorf("can't print the end of the %s output: %w", c.cfg.Formats[i].Format, err)
+			}
+
+			continue
+		}
+
+		err := c.printReports(issues, &c.cfg.Formats[i])
 		if err != nil {
 			return err
 		}
@@ -63,7 +142,74 @@ func (c *Printer) Print(issues []result.Issue) error {
 	return nil
 }
 
-func (c *Printer) printReports(issues []result.Issue, format config.OutputFormat) error {
+// printStreamSummary ends a streaming output: the issues are already printed, the summary counts the final issues.
+func (*Printer) printStreamSummary(s *stream, issues []result.Issue, format *config.OutputFormat) error {
+	issues, err := filterIssues(issues, format)
+	if err != nil {
+		return err
+	}
+
+	return s.p.PrintSummary(issues)
+}
+
+// CloseStreams ends the streaming outputs when the run failed: the summary records the error, without final issues.
+func (c *Printer) CloseStreams(runErr error) error {
+	if len(c.streams) == 0 {
+		return nil
+	}
+
+	if c.reportData.Error == "" {
+		c.reportData.Error = runErr.Error()
+	}
+
+	var errs error
+
+	for index, s := range c.streams {
+		err := s.p.PrintSummary(nil)
+		s.close()
+
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("can't print the end of the %s output: %w", c.cfg.Formats[index].Format, err))
+		}
+
+		delete(c.streams, index)
+	}
+
+	return errs
+}
+
+func (c *Printer) openStream(index int) (*stream, error) {
+	if s, ok := c.streams[index]; ok {
+		return s, nil
+	}
+
+	format := &c.cfg.Formats[index]
+
+	w, shouldClose, err := c.createWriter(format.Path)
+	if err != nil {
+		return nil, fmt.Errorf("can't create output for %s: %w", format.Path, err)
+	}
+
+	s := &stream{
+		p: NewNDJSON(c.reportData, w),
+		close: func() {
+			if file, ok := w.(io.Closer); shouldClose && ok {
+				_ = file.Close()
+			}
+		},
+	}
+
+	c.streams[index] = s
+
+	return s, nil
+}
+
+func (c *Printer) printReports(issues []result.Issue, format *config.OutputFormat) error {
+	issues, err := filterIssues(issues, format)
+	if err != nil {
+		return fmt.Errorf("can't filter issues for %s: %w", format.Format, err)
+	}
+
 	w, shouldClose, err := c.createWriter(format.Path)
 	if err != nil {
 		return fmt.Errorf("can't create output for %s: %w", format.Path, err)
@@ -75,7 +221,7 @@ func (c *Printer) printReports(issues []result.Issue, format config.OutputFormat
 		}
 	}()
 
-	p, err := c.createPrinter(format.Format, w)
+	p, err := c.createPrinter(format, w)
 	if err != nil {
 		return err
 	}
@@ -109,19 +255,22 @@ func (c *Printer) createWriter(path string) (io.Writer, bool, error) {
 	return f, true, nil
 }
 
-func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error) {
+//nolint:gocyclo // the complexity cannot be reduced.
+func (c *Printer) createPrinter(outputFormat *config.OutputFormat, w io.Writer) (issuePrinter, error) {
 	var p issuePrinter
 
+	format := outputFormat.Format
+
 	switch format {
 	case config.OutFormatJSON:
 		p = NewJSON(c.reportData, w)
 	case config.OutFormatLineNumber, config.OutFormatColoredLineNumber:
-		p = NewText(c.cfg.PrintIssuedLine,
-			format == config.OutFormatColoredLineNumber, c.cfg.PrintLinterName,
+		p = NewText(c.printIssuedLine(outputFormat),
+			useColors(outputFormat, config.OutFormatColoredLineNumber), c.cfg.PrintLinterName,
 			c.log.Child(logutils.DebugKeyTextPrinter), w)
 	case config.OutFormatTab, config.OutFormatColoredTab:
 		p = NewTab(c.cfg.PrintLinterName,
-			format == config.OutFormatColoredTab,
+			useColors(outputFormat, config.OutFormatColoredTab),
 			c.log.Child(logutils.DebugKeyTabPrinter), w)
 	case config.OutFormatCheckstyle:
 		p = NewCheckstyle(w)
@@ -136,10 +285,88 @@ func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error
 	case config.OutFormatTeamCity:
 		p = NewTeamCity(w)
 	case config.OutFormatSarif:
-		p = NewSarif(w)
+		p = NewSarif(c.reportData, w)
+	case config.OutFormatRDJSON, config.OutFormatRDJSONL:
+		p = NewRDJSON(c.reportData, format == config.OutFormatRDJSONL, w)
+	case config.OutFormatMarkdown:
+		p = NewMarkdown(c.reportData, cmp.Or(c.cfg.Markdown.MaxSize, defaultMarkdownMaxSize), w)
+	case config.OutFormatSonarQube:
+		p = NewSonarQube(&c.cfg.SonarQube, w)
+	case config.OutFormatNDJSON:
+		p = NewNDJSON(c.reportData, w)
+	case config.OutFormatTemplate:
+		return c.createTemplatePrinter(outputFormat.Template, w)
 	default:
 		return nil, fmt.Errorf("unknown output format %q", format)
 	}
 
 	return p, nil
 }
+
+// printIssuedLine returns the print-issued-lines option of the output, or the global option.
+func (c *Printer) printIssuedLine(format *config.OutputFormat) bool {
+	if format.PrintIssuedLines != nil {
+		return *format.PrintIssuedLines
+	}
+
+	return c.cfg.PrintIssuedLine
+}
+
+// useColors returns the colors option of the output, or true for the colored variant of the format.
+func useColors(format *config.OutputFormat, coloredFormat string) bool {
+	if format.Colors != nil {
+		return *format.Colors
+	}
+
+	return format.Format == coloredFormat
+}
+
+func (c *Printer) createTemplatePrinter(path string, w io.Writer) (issuePrinter, error) {
+	tmpl, ok := c.templates[path]
+	if !ok {
+		return nil, fmt.Errorf("the template %s is not parsed", path)
+	}
+
+	return NewTemplate(c.reportData, tmpl, w), nil
+}
+
+// parseTemplates parses the templates of the template outputs.
+func parseTemplates(cfg *config.Output) (map[string]*template.Template, error) {
+	templates := map[string]*template.Template{}
+
+	for i := range cfg.Formats {
+		format := &cfg.Formats[i]
+
+		if format.Format != config.OutFormatTemplate {
+			continue
+		}
+
+		if _, ok := templates[format.Template]; ok {
+			continue
+		}
+
+		tmpl, err := ParseTemplateFile(format.Template)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", format.Template, err)
+		}
+
+		templates[format.Template] = tmpl
+	}
+
+	return templates, nil
+}
+
+// findLinterData returns the data of the linter, or nil if it is unknown.
+func findLinterData(rd *report.Data, name string) *report.LinterData {
+	if rd == nil {
+		return nil
+	}
+
+	for i := range rd.Linters {
+		if rd.Linters[i].Name == name {
+			return &rd.Linters[i]
+		}
+	}
+
+	return nil
+}
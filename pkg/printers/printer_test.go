@@ -3,6 +3,7 @@ package printers
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -169,6 +170,38 @@ func TestPrinter_Print_file(t *testing.T) {
 	assert.Equal(t, string(golden), string(actual))
 }
 
+func TestNewPrinter_invalidTemplate(t *testing.T) {
+	dir := t.TempDir()
+
+	templatePath := filepath.Join(dir, "report.tmpl")
+
+	err := os.WriteFile(templatePath, []byte("{{ .Issues "), 0o600)
+	require.NoError(t, err)
+
+	outputPath := filepath.Join(dir, "report.txt")
+
+	err = os.WriteFile(outputPath, []byte("previous report"), 0o600)
+	require.NoError(t, err)
+
+	cfg := &config.Output{
+		Formats: []config.OutputFormat{
+			{Format: "json", Path: filepath.Join(dir, "report.json")},
+			{Format: "template", Template: templatePath, Path: outputPath},
+		},
+	}
+
+	_, err = NewPrinter(logutils.NewStderrLog("skip"), cfg, &report.Data{})
+	require.ErrorContains(t, err, "can't parse the template")
+
+	// The outputs are not created.
+	assert.NoFileExists(t, filepath.Join(dir, "report.json"))
+
+	actual, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "previous report", string(actual))
+}
+
 func TestPrinter_Print_multiple(t *testing.T) {
 	logger := logutils.NewStderrLog("skip")
 
@@ -227,3 +260,114 @@ func TestPrinter_Print_multiple(t *testing.T) {
 
 	assert.JSONEq(t, string(goldenJSON), stdOutBuffer.String())
 }
+
+func TestPrinter_Stream(t *testing.T) {
+	logger := logutils.NewStderrLog("skip")
+
+	var issues []result.Issue
+	unmarshalFile(t, "in-issues.json", &issues)
+
+	data := &report.Data{}
+	unmarshalFile(t, "in-report-data.json", data)
+
+	outputPath := filepath.Join(t.TempDir(), "report.ndjson")
+
+	cfg := &config.Output{
+		Formats: []config.OutputFormat{
+			{
+				Format: "ndjson",
+				Path:   outputPath,
+			},
+			{
+				Format: "line-number",
+				Path:   "stderr",
+			},
+		},
+	}
+
+	p, err := NewPrinter(logger, cfg, data)
+	require.NoError(t, err)
+
+	require.True(t, p.CanStream())
+
+	var stdOutBuffer bytes.Buffer
+	p.stdOut = &stdOutBuffer
+
+	var stdErrBuffer bytes.Buffer
+	p.stdErr = &stdErrBuffer
+
+	err = p.Stream(issues[:1])
+	require.NoError(t, err)
+
+	err = p.Stream(issues[1:])
+	require.NoError(t, err)
+
+	// The streaming output is written while the linters are running, the other outputs at the end.
+	streamed, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, len(issues), bytes.Count(streamed, []byte("\n")))
+	assert.Equal(t, 0, stdErrBuffer.Len())
+
+	err = p.Print(issues)
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(actual), []byte("\n"))
+	require.Len(t, lines, len(issues)+1)
+
+	var summary ndjsonSummary
+	err = json.Unmarshal(lines[len(lines)-1], &summary)
+	require.NoError(t, err)
+
+	expected := ndjsonSummary{Type: ndjsonTypeSummary, IssuesCount: len(issues), IssueRecordsCount: len(issues), Report: data}
+
+	assert.Equal(t, expected, summary)
+
+	goldenLineNumber, err := os.ReadFile(filepath.Join("testdata", "golden-line-number.txt"))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(goldenLineNumber), stdErrBuffer.String())
+	assert.Equal(t, 0, stdOutBuffer.Len())
+}
+
+func TestPrinter_CloseStreams(t *testing.T) {
+	logger := logutils.NewStderrLog("skip")
+
+	var issues []result.Issue
+	unmarshalFile(t, "in-issues.json", &issues)
+
+	outputPath := filepath.Join(t.TempDir(), "report.ndjson")
+
+	cfg := &config.Output{
+		Formats: []config.OutputFormat{{Format: "ndjson", Path: outputPath}},
+	}
+
+	data := &report.Data{}
+
+	p, err := NewPrinter(logger, cfg, data)
+	require.NoError(t, err)
+
+	err = p.Stream(issues[:1])
+	require.NoError(t, err)
+
+	err = p.CloseStreams(errors.New("can't run linter"))
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(actual), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var summary ndjsonSummary
+	err = json.Unmarshal(lines[1], &summary)
+	require.NoError(t, err)
+
+	// Without final result, the summary only counts the streamed issues.
+	expected := ndjsonSummary{Type: ndjsonTypeSummary, IssueRecordsCount: 1, Report: &report.Data{Error: "can't run linter"}}
+
+	assert.Equal(t, expected, summary)
+}
@@ -0,0 +1,192 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const rdjsonSourceName = "golangci-lint"
+
+// The severities of the Reviewdog Diagnostic Format.
+const (
+	rdjsonSeverityError   = "ERROR"
+	rdjsonSeverityWarning = "WARNING"
+	rdjsonSeverityInfo    = "INFO"
+)
+
+// rdjsonResult is the Reviewdog Diagnostic Format (rdjson).
+// https://github.com/reviewdog/reviewdog/tree/master/proto/rdf
+type rdjsonResult struct {
+	Source      *rdjsonSource      `json:"source,omitempty"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+type rdjsonDiagnostic struct {
+	Message     string             `json:"message"`
+	Location    rdjsonLocation     `json:"location"`
+	Severity    string             `json:"severity,omitempty"`
+	Source      *rdjsonSource      `json:"source,omitempty"`
+	Code        *rdjsonCode        `json:"code,omitempty"`
+	Suggestions []rdjsonSuggestion `json:"suggestions,omitempty"`
+}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+type rdjsonLocation struct {
+	Path  string      `json:"path"`
+	Range rdjsonRange `json:"range"`
+}
+
+type rdjsonRange struct {
+	Start rdjsonPosition  `json:"start"`
+	End   *rdjsonPosition `json:"end,omitempty"`
+}
+
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+type rdjsonSuggestion struct {
+	Range rdjsonRange `json:"range"`
+	Text  string      `json:"text"`
+}
+
+// RDJSON prints the issues in the Reviewdog Diagnostic Format:
+// a single object (rdjson) or one diagnostic per line (rdjsonl).
+type RDJSON struct {
+	rd        *report.Data
+	jsonLines bool
+	w         io.Writer
+}
+
+func NewRDJSON(rd *report.Data, jsonLines bool, w io.Writer) *RDJSON {
+	return &RDJSON{
+		rd:        rd,
+		jsonLines: jsonLines,
+		w:         w,
+	}
+}
+
+func (p RDJSON) Print(issues []result.Issue) error {
+	diagnostics := make([]rdjsonDiagnostic, 0, len(issues))
+
+	for i := range issues {
+		diagnostic := p.newDiagnostic(&issues[i])
+
+		if p.jsonLines {
+			// Each line is self-contained.
+			diagnostic.Source = &rdjsonSource{Name: rdjsonSourceName}
+		}
+
+		diagnostics = append(diagnostics, diagnostic)
+	}
+
+	encoder := json.NewEncoder(p.w)
+
+	if !p.jsonLines {
+		return encoder.Encode(rdjsonResult{
+			Source:      &rdjsonSource{Name: rdjsonSourceName},
+			Diagnostics: diagnostics,
+		})
+	}
+
+	for i := range diagnostics {
+		if err := encoder.Encode(diagnostics[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p RDJSON) newDiagnostic(issue *result.Issue) rdjsonDiagnostic {
+	diagnostic := rdjsonDiagnostic{
+		Message: issue.Text,
+		Location: rdjsonLocation{
+			Path:  issue.FilePath(),
+			Range: rdjsonRange{Start: rdjsonPosition{Line: issue.Line(), Column: issue.Column()}},
+		},
+		Severity: rdjsonSeverities[strings.ToLower(issue.Severity)],
+		Code:     &rdjsonCode{Value: issue.FromLinter},
+	}
+
+	if lc := findLinterData(p.rd, issue.FromLinter); lc != nil {
+		diagnostic.Code.URL = lc.URL
+	}
+
+	if issue.RuleID != "" {
+		diagnostic.Code.Value = issue.FromLinter + "(" + issue.RuleID + ")"
+	}
+
+	if lineRange := issue.GetLineRange(); lineRange.To > issue.Line() {
+		diagnostic.Location.Range.End = &rdjsonPosition{Line: lineRange.To}
+	}
+
+	if suggestion := newRDJSONSuggestion(issue); suggestion != nil {
+		diagnostic.Suggestions = []rdjsonSuggestion{*suggestion}
+	}
+
+	return diagnostic
+}
+
+// rdjsonSeverities maps the severities of the issues to the severities of the Reviewdog Diagnostic Format.
+// The unknown severities are not reported.
+var rdjsonSeverities = map[string]string{
+	"error":    rdjsonSeverityError,
+	"high":     rdjsonSeverityError,
+	"critical": rdjsonSeverityError,
+	"blocker":  rdjsonSeverityError,
+	"warning":  rdjsonSeverityWarning,
+	"medium":   rdjsonSeverityWarning,
+	"major":    rdjsonSeverityWarning,
+	"info":     rdjsonSeverityInfo,
+	"note":     rdjsonSeverityInfo,
+	"low":      rdjsonSeverityInfo,
+	"minor":    rdjsonSeverityInfo,
+}
+
+// newRDJSONSuggestion converts the replacement of the issue to a suggestion.
+// The columns are 1-based, and the end of the range is exclusive.
+func newRDJSONSuggestion(issue *result.Issue) *rdjsonSuggestion {
+	if issue.Replacement == nil {
+		return nil
+	}
+
+	if inline := issue.Replacement.Inline; inline != nil {
+		return &rdjsonSuggestion{
+			Range: rdjsonRange{
+				Start: rdjsonPosition{Line: issue.Line(), Column: inline.StartCol + 1},
+				End:   &rdjsonPosition{Line: issue.Line(), Column: inline.StartCol + inline.Length + 1},
+			},
+			Text: inline.NewString,
+		}
+	}
+
+	lineRange := issue.GetLineRange()
+
+	suggestion := &rdjsonSuggestion{
+		Range: rdjsonRange{
+			Start: rdjsonPosition{Line: lineRange.From, Column: 1},
+			End:   &rdjsonPosition{Line: lineRange.To + 1, Column: 1},
+		},
+	}
+
+	if !issue.Replacement.NeedOnlyDelete {
+		suggestion.Text = strings.Join(issue.Replacement.NewLines, "\n") + "\n"
+	}
+
+	return suggestion
+}
@@ -0,0 +1,121 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestRDJSON_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			RuleID:     "B001",
+			Severity:   "high",
+			Text:       "another issue",
+			SourceLines: []string{
+				"func foo() {",
+				"\tfmt.Println(\"bar\")",
+				"}",
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+			Replacement: &result.Replacement{
+				NewLines: []string{"func foo() {}"},
+			},
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+		{
+			FromLinter: "linter-a",
+			Text:       "some issue 2",
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 4, Length: 2, NewString: "new"},
+			},
+			Pos: token.Position{
+				Filename: "path/to/filec.go",
+				Offset:   3,
+				Line:     11,
+				Column:   5,
+			},
+		},
+	}
+
+	rd := &report.Data{
+		Linters: []report.LinterData{
+			{Name: "linter-a", URL: "https://example.com/linter-a"},
+		},
+	}
+
+	testCases := []struct {
+		desc      string
+		jsonLines bool
+		expected  string
+	}{
+		{
+			desc: "rdjson",
+			expected: `{"source":{"name":"golangci-lint"},"diagnostics":[` +
+				`{"message":"some issue","location":{"path":"path/to/filea.go","range":{"start":{"line":10,"column":4}}},"severity":"WARNING","code":{"value":"linter-a","url":"https://example.com/linter-a"}},` +
+				`{"message":"another issue","location":{"path":"path/to/fileb.go","range":{"start":{"line":300,"column":9},"end":{"line":302}}},"severity":"ERROR","code":{"value":"linter-b(B001)"},"suggestions":[{"range":{"start":{"line":300,"column":1},"end":{"line":303,"column":1}},"text":"func foo() {}\n"}]},` +
+				`{"message":"some issue 2","location":{"path":"path/to/filec.go","range":{"start":{"line":11,"column":5}}},"code":{"value":"linter-a","url":"https://example.com/linter-a"},"suggestions":[{"range":{"start":{"line":11,"column":5},"end":{"line":11,"column":7}},"text":"new"}]}]}
+`,
+		},
+		{
+			desc:      "rdjsonl",
+			jsonLines: true,
+			expected: `{"message":"some issue","location":{"path":"path/to/filea.go","range":{"start":{"line":10,"column":4}}},"severity":"WARNING","source":{"name":"golangci-lint"},"code":{"value":"linter-a","url":"https://example.com/linter-a"}}
+{"message":"another issue","location":{"path":"path/to/fileb.go","range":{"start":{"line":300,"column":9},"end":{"line":302}}},"severity":"ERROR","source":{"name":"golangci-lint"},"code":{"value":"linter-b(B001)"},"suggestions":[{"range":{"start":{"line":300,"column":1},"end":{"line":303,"column":1}},"text":"func foo() {}\n"}]}
+{"message":"some issue 2","location":{"path":"path/to/filec.go","range":{"start":{"line":11,"column":5}}},"source":{"name":"golangci-lint"},"code":{"value":"linter-a","url":"https://example.com/linter-a"},"suggestions":[{"range":{"start":{"line":11,"column":5},"end":{"line":11,"column":7}},"text":"new"}]}
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			buf := new(bytes.Buffer)
+
+			printer := NewRDJSON(rd, test.jsonLines, buf)
+
+			err := printer.Print(issues)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestRDJSON_Print_empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	printer := NewRDJSON(nil, false, buf)
+
+	err := printer.Print(nil)
+	require.NoError(t, err)
+
+	expected := `{"source":{"name":"golangci-lint"},"diagnostics":[]}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
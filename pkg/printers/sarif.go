@@ -3,7 +3,9 @@ package printers
 import (
 	"encoding/json"
 	"io"
+	"strings"
 
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
@@ -12,6 +14,10 @@ const (
 	sarifSchemaURI = "https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json"
 )
 
+// sarifFingerprintKey is the key of the fingerprint used by the code scanning tools to track the results between the runs.
+// The fingerprint is specific to golangci-lint (`primaryLocationLineHash` is computed by the code scanning tools).
+const sarifFingerprintKey = "golangciFingerprint/v1"
+
 type SarifOutput struct {
 	Version string     `json:"version"`
 	Schema  string     `json:"$schema"`
@@ -19,21 +25,45 @@ type SarifOutput struct {
 }
 
 type sarifRun struct {
-	Tool    sarifTool     `json:"tool"`
-	Results []sarifResult `json:"results"`
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations,omitempty"`
+	Results     []sarifResult     `json:"results"`
 }
 
 type sarifTool struct {
 	Driver struct {
-		Name string `json:"name"`
+		Name    string      `json:"name"`
+		Version string      `json:"version,omitempty"`
+		Rules   []sarifRule `json:"rules,omitempty"`
 	} `json:"driver"`
 }
 
+type sarifRule struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	HelpURI          string        `json:"helpUri,omitempty"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ExitCode                   int                 `json:"exitCode"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
 type sarifResult struct {
-	RuleID    string          `json:"ruleId"`
-	Level     string          `json:"level"`
-	Message   sarifMessage    `json:"message"`
-	Locations []sarifLocation `json:"locations"`
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           *int              `json:"ruleIndex,omitempty"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+	Fixes               []sarifFix        `json:"fixes,omitempty"`
+	Properties          map[string]string `json:"properties,omitempty"`
 }
 
 type sarifMessage struct {
@@ -56,15 +86,36 @@ type sarifArtifactLocation struct {
 
 type sarifRegion struct {
 	StartLine   int `json:"startLine"`
-	StartColumn int `json:"startColumn"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion   `json:"deletedRegion"`
+	InsertedContent *sarifMessage `json:"insertedContent,omitempty"`
 }
 
 type Sarif struct {
-	w io.Writer
+	rd *report.Data
+	w  io.Writer
 }
 
-func NewSarif(w io.Writer) *Sarif {
-	return &Sarif{w: w}
+func NewSarif(rd *report.Data, w io.Writer) *Sarif {
+	return &Sarif{
+		rd: rd,
+		w:  w,
+	}
 }
 
 func (p Sarif) Print(issues []result.Issue) error {
@@ -72,6 +123,13 @@ func (p Sarif) Print(issues []result.Issue) error {
 	run.Tool.Driver.Name = "golangci-lint"
 	run.Results = make([]sarifResult, 0)
 
+	if p.rd != nil {
+		run.Tool.Driver.Version = p.rd.Version
+		run.Invocations = []sarifInvocation{p.newInvocation()}
+	}
+
+	ruleIndexes := map[string]int{}
+
 	for i := range issues {
 		issue := issues[i]
 
@@ -85,23 +143,44 @@ func (p Sarif) Print(issues []result.Issue) error {
 			severity = "error"
 		}
 
+		index, ok := ruleIndexes[issue.FromLinter]
+		if !ok {
+			index = len(run.Tool.Driver.Rules)
+			ruleIndexes[issue.FromLinter] = index
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, p.newRule(issue.FromLinter))
+		}
+
+		region := sarifRegion{
+			StartLine: issue.Line(),
+			// If startColumn is absent, it SHALL default to 1.
+			// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
+			StartColumn: max(1, issue.Column()),
+		}
+
+		if lineRange := issue.GetLineRange(); lineRange.To > issue.Line() {
+			region.EndLine = lineRange.To
+		}
+
 		sr := sarifResult{
-			RuleID:  issue.FromLinter,
-			Level:   severity,
-			Message: sarifMessage{Text: issue.Text},
+			RuleID:    issue.FromLinter,
+			RuleIndex: &index,
+			Level:     severity,
+			Message:   sarifMessage{Text: issue.Text},
 			Locations: []sarifLocation{
 				{
 					PhysicalLocation: sarifPhysicalLocation{
 						ArtifactLocation: sarifArtifactLocation{URI: issue.FilePath()},
-						Region: sarifRegion{
-							StartLine: issue.Line(),
-							// If startColumn is absent, it SHALL default to 1.
-							// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
-							StartColumn: max(1, issue.Column()),
-						},
+						Region:           region,
 					},
 				},
 			},
+			PartialFingerprints: map[string]string{sarifFingerprintKey: issue.Fingerprint()},
+			Fixes:               newSarifFixes(&issue),
+		}
+
+		// The rule of the linter is a property: the SARIF rules are the linters.
+		if issue.RuleID != "" {
+			sr.Properties = map[string]string{"rule": issue.RuleID}
 		}
 
 		run.Results = append(run.Results, sr)
@@ -115,3 +194,84 @@ func (p Sarif) Print(issues []result.Issue) error {
 
 	return json.NewEncoder(p.w).Encode(output)
 }
+
+// newRule creates the rule of a linter, with the description and the URL of the linter if they are known.
+func (p Sarif) newRule(linterName string) sarifRule {
+	rule := sarifRule{ID: linterName}
+
+	ld := findLinterData(p.rd, linterName)
+	if ld == nil {
+		return rule
+	}
+
+	if ld.Desc != "" {
+		rule.ShortDescription = &sarifMessage{Text: ld.Desc}
+	}
+
+	rule.HelpURI = ld.URL
+
+	return rule
+}
+
+// newInvocation reports the status of the execution, and the warnings and the error logged during the execution.
+func (p Sarif) newInvocation() sarifInvocation {
+	invocation := sarifInvocation{ExecutionSuccessful: p.rd.Error == "", ExitCode: p.rd.ExitCode}
+
+	for _, warning := range p.rd.Warnings {
+		text := warning.Text
+		if warning.Tag != "" {
+			text = warning.Tag + ": " + text
+		}
+
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications,
+			sarifNotification{Level: "warning", Message: sarifMessage{Text: text}})
+	}
+
+	if p.rd.Error != "" {
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications,
+			sarifNotification{Level: "error", Message: sarifMessage{Text: p.rd.Error}})
+	}
+
+	return invocation
+}
+
+// newSarifFixes converts the replacement of the issue to a SARIF fix.
+func newSarifFixes(issue *result.Issue) []sarifFix {
+	if issue.Replacement == nil {
+		return nil
+	}
+
+	var replacement sarifReplacement
+
+	if inline := issue.Replacement.Inline; inline != nil {
+		replacement = sarifReplacement{
+			// The SARIF columns are 1-based.
+			DeletedRegion: sarifRegion{
+				StartLine:   issue.Line(),
+				StartColumn: inline.StartCol + 1,
+				EndLine:     issue.Line(),
+				EndColumn:   inline.StartCol + inline.Length + 1,
+			},
+			InsertedContent: &sarifMessage{Text: inline.NewString},
+		}
+	} else {
+		lineRange := issue.GetLineRange()
+
+		// A region without columns covers the whole lines.
+		replacement = sarifReplacement{
+			DeletedRegion: sarifRegion{StartLine: lineRange.From, EndLine: lineRange.To},
+		}
+
+		if !issue.Replacement.NeedOnlyDelete {
+			replacement.InsertedContent = &sarifMessage{Text: strings.Join(issue.Replacement.NewLines, "\n")}
+		}
+	}
+
+	return []sarifFix{{
+		Description: sarifMessage{Text: "Fix suggested by " + issue.FromLinter},
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: sarifArtifactLocation{URI: issue.FilePath()},
+			Replacements:     []sarifReplacement{replacement},
+		}},
+	}}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
@@ -33,6 +34,10 @@ func TestSarif_Print(t *testing.T) {
 				"\tfmt.Println(\"bar\")",
 				"}",
 			},
+			LineRange: &result.Range{From: 300, To: 302},
+			Replacement: &result.Replacement{
+				NewLines: []string{"func foo() {}"},
+			},
 			Pos: token.Position{
 				Filename: "path/to/fileb.go",
 				Offset:   5,
@@ -44,6 +49,9 @@ func TestSarif_Print(t *testing.T) {
 			FromLinter: "linter-a",
 			Severity:   "low",
 			Text:       "some issue 2",
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 4, Length: 2, NewString: "new"},
+			},
 			Pos: token.Position{
 				Filename: "path/to/filec.go",
 				Offset:   3,
@@ -53,6 +61,7 @@ func TestSarif_Print(t *testing.T) {
 		},
 		{
 			FromLinter: "linter-c",
+			RuleID:     "C001",
 			Severity:   "error",
 			Text:       "some issue without column",
 			Pos: token.Position{
@@ -63,14 +72,24 @@ func TestSarif_Print(t *testing.T) {
 		},
 	}
 
+	rd := &report.Data{
+		Version: "1.2.3",
+		Linters: []report.LinterData{
+			{Name: "linter-a", Desc: "Linter A.", URL: "https://example.com/linter-a"},
+			{Name: "linter-b"},
+		},
+		Warnings: []report.Warning{{Tag: "runner", Text: "some warning"}},
+		ExitCode: 1,
+	}
+
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(rd, buf)
 
 	err := printer.Print(issues)
 	require.NoError(t, err)
 
-	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint"}},"results":[{"ruleId":"linter-a","level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}]},{"ruleId":"linter-b","level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":300,"startColumn":9}}}]},{"ruleId":"linter-a","level":"error","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":11,"startColumn":5}}}]},{"ruleId":"linter-c","level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":0},"region":{"startLine":11,"startColumn":1}}}]}]}]}
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","version":"1.2.3","rules":[{"id":"linter-a","shortDescription":{"text":"Linter A."},"helpUri":"https://example.com/linter-a"},{"id":"linter-b"},{"id":"linter-c"}]}},"invocations":[{"executionSuccessful":true,"exitCode":1,"toolExecutionNotifications":[{"level":"warning","message":{"text":"runner: some warning"}}]}],"results":[{"ruleId":"linter-a","ruleIndex":0,"level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}],"partialFingerprints":{"golangciFingerprint/v1":"BA73C5DF4A6FD8462FFF1D3140235777"}},{"ruleId":"linter-b","ruleIndex":1,"level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":300,"startColumn":9,"endLine":302}}}],"partialFingerprints":{"golangciFingerprint/v1":"0777B4FE60242BD8B2E9B7E92C4B9521"},"fixes":[{"description":{"text":"Fix suggested by linter-b"},"artifactChanges":[{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"replacements":[{"deletedRegion":{"startLine":300,"endLine":302},"insertedContent":{"text":"func foo() {}"}}]}]}]},{"ruleId":"linter-a","ruleIndex":0,"level":"error","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":11,"startColumn":5}}}],"partialFingerprints":{"golangciFingerprint/v1":"9AD407FB6D175EE4AF478033CB5AD963"},"fixes":[{"description":{"text":"Fix suggested by linter-a"},"artifactChanges":[{"artifactLocation":{"uri":"path/to/filec.go","index":0},"replacements":[{"deletedRegion":{"startLine":11,"startColumn":5,"endLine":11,"endColumn":7},"insertedContent":{"text":"new"}}]}]}]},{"ruleId":"linter-c","ruleIndex":2,"level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":0},"region":{"startLine":11,"startColumn":1}}}],"partialFingerprints":{"golangciFingerprint/v1":"081757E6B3868FCC8765CBD50C71E952"},"properties":{"rule":"C001"}}]}]}
 `
 
 	assert.Equal(t, expected, buf.String())
@@ -79,7 +98,7 @@ func TestSarif_Print(t *testing.T) {
 func TestSarif_Print_empty(t *testing.T) {
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(nil, buf)
 
 	err := printer.Print(nil)
 	require.NoError(t, err)
@@ -0,0 +1,183 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const sonarQubeEngineID = "golangci-lint"
+
+const (
+	defaultSonarQubeSeverity = "MAJOR"
+	defaultSonarQubeType     = "CODE_SMELL"
+)
+
+// sonarQubeSeveritiesByRank maps the ranks of the common severities (see config.SeverityRank) to the SonarQube severities.
+var sonarQubeSeveritiesByRank = map[int]string{
+	1: "MINOR",
+	2: "MAJOR",
+	3: "CRITICAL",
+	4: "CRITICAL",
+	5: "BLOCKER",
+}
+
+// defaultSonarQubeTypes maps the linters to the SonarQube types, the other linters report code smells.
+var defaultSonarQubeTypes = map[string]string{
+	"gosec":       "VULNERABILITY",
+	"govulncheck": "VULNERABILITY",
+	"errcheck":    "BUG",
+	"govet":       "BUG",
+	"staticcheck": "BUG",
+	"typecheck":   "BUG",
+}
+
+// sonarQubeResult is the SonarQube generic issue data.
+// https://docs.sonarsource.com/sonarqube/latest/analyzing-source-code/importing-external-issues/generic-issue-import-format/
+type sonarQubeResult struct {
+	Issues []sonarQubeIssue `json:"issues"`
+}
+
+type sonarQubeIssue struct {
+	EngineID        string            `json:"engineId"`
+	RuleID          string            `json:"ruleId"`
+	Severity        string            `json:"severity"`
+	Type            string            `json:"type"`
+	PrimaryLocation sonarQubeLocation `json:"primaryLocation"`
+}
+
+type sonarQubeLocation struct {
+	Message   string             `json:"message"`
+	FilePath  string             `json:"filePath"`
+	TextRange sonarQubeTextRange `json:"textRange"`
+}
+
+// sonarQubeTextRange is the range of the issue: the lines are 1-based and the columns are 0-based.
+type sonarQubeTextRange struct {
+	StartLine   int  `json:"startLine"`
+	EndLine     int  `json:"endLine,omitempty"`
+	StartColumn *int `json:"startColumn,omitempty"`
+	EndColumn   *int `json:"endColumn,omitempty"`
+}
+
+// SonarQube prints the issues in the SonarQube generic issue data format.
+// The severities and the types are mapped with the configured tables, then with the default mappings.
+type SonarQube struct {
+	severities      map[string]string
+	types           map[string]string
+	defaultSeverity string
+	defaultType     string
+
+	w io.Writer
+}
+
+func NewSonarQube(cfg *config.SonarQube, w io.Writer) *SonarQube {
+	p := &SonarQube{
+		severities:      map[string]string{},
+		types:           map[string]string{},
+		defaultSeverity: defaultSonarQubeSeverity,
+		defaultType:     defaultSonarQubeType,
+		w:               w,
+	}
+
+	for k, v := range defaultSonarQubeTypes {
+		p.types[k] = v
+	}
+
+	if cfg == nil {
+		return p
+	}
+
+	for k, v := range cfg.Severities {
+		p.severities[strings.ToLower(k)] = v
+	}
+
+	for k, v := range cfg.Types {
+		p.types[strings.ToLower(k)] = v
+	}
+
+	if cfg.DefaultSeverity != "" {
+		p.defaultSeverity = cfg.DefaultSeverity
+	}
+
+	if cfg.DefaultType != "" {
+		p.defaultType = cfg.DefaultType
+	}
+
+	return p
+}
+
+func (p SonarQube) Print(issues []result.Issue) error {
+	res := sonarQubeResult{Issues: make([]sonarQubeIssue, 0, len(issues))}
+
+	for i := range issues {
+		res.Issues = append(res.Issues, p.newIssue(&issues[i]))
+	}
+
+	return json.NewEncoder(p.w).Encode(res)
+}
+
+func (p SonarQube) newIssue(issue *result.Issue) sonarQubeIssue {
+	ruleID := issue.FromLinter
+	if issue.RuleID != "" {
+		ruleID += "(" + issue.RuleID + ")"
+	}
+
+	severity, ok := p.severities[strings.ToLower(issue.Severity)]
+	if !ok {
+		severity, ok = sonarQubeSeveritiesByRank[config.SeverityRank(issue.Severity)]
+	}
+
+	if !ok {
+		severity = p.defaultSeverity
+	}
+
+	issueType, ok := p.types[strings.ToLower(issue.FromLinter)]
+	if !ok {
+		issueType = p.defaultType
+	}
+
+	return sonarQubeIssue{
+		EngineID: sonarQubeEngineID,
+		RuleID:   ruleID,
+		Severity: severity,
+		Type:     issueType,
+		PrimaryLocation: sonarQubeLocation{
+			Message:   issue.Text,
+			FilePath:  issue.FilePath(),
+			TextRange: newSonarQubeTextRange(issue),
+		},
+	}
+}
+
+// newSonarQubeTextRange uses the range of the lines and the range of the inline fix when they are available.
+func newSonarQubeTextRange(issue *result.Issue) sonarQubeTextRange {
+	textRange := sonarQubeTextRange{StartLine: issue.Line()}
+
+	if lineRange := issue.GetLineRange(); lineRange.To > issue.Line() {
+		textRange.EndLine = lineRange.To
+	}
+
+	if issue.Replacement != nil && issue.Replacement.Inline != nil && textRange.EndLine == 0 {
+		inline := issue.Replacement.Inline
+
+		start, end := inline.StartCol, inline.StartCol+inline.Length
+		if end > start {
+			textRange.EndLine = issue.Line()
+			textRange.StartColumn = &start
+			textRange.EndColumn = &end
+
+			return textRange
+		}
+	}
+
+	if issue.Column() > 0 {
+		start := issue.Column() - 1
+		textRange.StartColumn = &start
+	}
+
+	return textRange
+}
@@ -0,0 +1,119 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestSonarQube_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "Medium",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "gosec",
+			RuleID:     "G104",
+			Severity:   "Error",
+			Text:       "another issue",
+			SourceLines: []string{
+				"func foo() {",
+				"\tfmt.Println(\"bar\")",
+				"}",
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "unknown",
+			Text:       "some issue 2",
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 4, Length: 2, NewString: "new"},
+			},
+			Pos: token.Position{
+				Filename: "path/to/filec.go",
+				Offset:   3,
+				Line:     11,
+				Column:   5,
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		cfg      *config.SonarQube
+		expected string
+	}{
+		{
+			desc: "default",
+			expected: `{"issues":[` +
+				`{"engineId":"golangci-lint","ruleId":"linter-a","severity":"MAJOR","type":"CODE_SMELL","primaryLocation":{"message":"some issue","filePath":"path/to/filea.go","textRange":{"startLine":10,"startColumn":3}}},` +
+				`{"engineId":"golangci-lint","ruleId":"gosec(G104)","severity":"CRITICAL","type":"VULNERABILITY","primaryLocation":{"message":"another issue","filePath":"path/to/fileb.go","textRange":{"startLine":300,"endLine":302,"startColumn":8}}},` +
+				`{"engineId":"golangci-lint","ruleId":"linter-b","severity":"MAJOR","type":"CODE_SMELL","primaryLocation":{"message":"some issue 2","filePath":"path/to/filec.go","textRange":{"startLine":11,"endLine":11,"startColumn":4,"endColumn":6}}}]}
+`,
+		},
+		{
+			desc: "custom mapping",
+			cfg: &config.SonarQube{
+				Severities:      map[string]string{"error": "BLOCKER"},
+				Types:           map[string]string{"linter-a": "BUG"},
+				DefaultSeverity: "MINOR",
+				DefaultType:     "VULNERABILITY",
+			},
+			expected: `{"issues":[` +
+				`{"engineId":"golangci-lint","ruleId":"linter-a","severity":"MAJOR","type":"BUG","primaryLocation":{"message":"some issue","filePath":"path/to/filea.go","textRange":{"startLine":10,"startColumn":3}}},` +
+				`{"engineId":"golangci-lint","ruleId":"gosec(G104)","severity":"BLOCKER","type":"VULNERABILITY","primaryLocation":{"message":"another issue","filePath":"path/to/fileb.go","textRange":{"startLine":300,"endLine":302,"startColumn":8}}},` +
+				`{"engineId":"golangci-lint","ruleId":"linter-b","severity":"MINOR","type":"VULNERABILITY","primaryLocation":{"message":"some issue 2","filePath":"path/to/filec.go","textRange":{"startLine":11,"endLine":11,"startColumn":4,"endColumn":6}}}]}
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			buf := new(bytes.Buffer)
+
+			printer := NewSonarQube(test.cfg, buf)
+
+			err := printer.Print(issues)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestSonarQube_Print_empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	printer := NewSonarQube(nil, buf)
+
+	err := printer.Print(nil)
+	require.NoError(t, err)
+
+	expected := `{"issues":[]}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
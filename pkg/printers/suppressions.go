@@ -0,0 +1,132 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// The formats of the suppressions report.
+const (
+	SuppressionsFormatText = "text"
+	SuppressionsFormatJSON = config.OutFormatJSON
+)
+
+// SuppressionsReport is the report of the issues hidden by the nolint directives, the exclusions,
+// and the limits of issues.
+type SuppressionsReport struct {
+	Total int
+
+	ByKind    map[string]int
+	ByLinter  map[string]int
+	ByPackage map[string]int
+	ByReason  map[string]int // the suppressions without reason are not counted.
+
+	Suppressions []result.Suppression
+}
+
+func NewSuppressionsReport(suppressions []result.Suppression) *SuppressionsReport {
+	r := &SuppressionsReport{
+		ByKind:       map[string]int{},
+		ByLinter:     map[string]int{},
+		ByPackage:    map[string]int{},
+		ByReason:     map[string]int{},
+		Suppressions: suppressions,
+	}
+
+	if r.Suppressions == nil {
+		r.Suppressions = []result.Suppression{}
+	}
+
+	for _, s := range suppressions {
+		r.Total += s.Count
+		r.ByKind[s.Kind] += s.Count
+		r.ByLinter[s.Linter] += s.Count
+
+		if s.Package != "" {
+			r.ByPackage[s.Package] += s.Count
+		}
+
+		if s.Reason != "" {
+			r.ByReason[s.Reason] += s.Count
+		}
+	}
+
+	return r
+}
+
+// PrintSuppressions prints the report of the suppressions in the format (text or json) and to the path of the output.
+func (c *Printer) PrintSuppressions(suppressions []result.Suppression, output *config.OutputFormat) error {
+	w, shouldClose, err := c.createWriter(output.Path)
+	if err != nil {
+		return fmt.Errorf("can't create output for %s: %w", output.Path, err)
+	}
+
+	defer func() {
+		if file, ok := w.(io.Closer); shouldClose && ok {
+			_ = file.Close()
+		}
+	}()
+
+	r := NewSuppressionsReport(suppressions)
+
+	switch output.Format {
+	case SuppressionsFormatText:
+		return r.printText(w)
+	case SuppressionsFormatJSON:
+		return json.NewEncoder(w).Encode(r)
+	default:
+		return fmt.Errorf("unknown suppressions format %q", output.Format)
+	}
+}
+
+func (r *SuppressionsReport) printText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "%d suppressed issues.\n", r.Total)
+
+	for _, group := range []struct {
+		title  string
+		counts map[string]int
+	}{
+		{title: "By kind", counts: r.ByKind},
+		{title: "By linter", counts: r.ByLinter},
+		{title: "By package", counts: r.ByPackage},
+		{title: "By reason", counts: r.ByReason},
+	} {
+		if len(group.counts) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(tw, "\n%s:\n", group.title)
+
+		keys := maps.Keys(group.counts)
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			fmt.Fprintf(tw, "* %s:\t%d\n", key, group.counts[key])
+		}
+	}
+
+	if len(r.Suppressions) > 0 {
+		fmt.Fprintln(tw, "\nSuppressions:")
+	}
+
+	for _, s := range r.Suppressions {
+		line := fmt.Sprintf("%s\t%s\t%s\t%s\t%d", s.Kind, s.Name, s.Linter, s.Package, s.Count)
+		if s.Reason != "" {
+			line += "\t" + s.Reason
+		}
+
+		fmt.Fprintln(tw, line)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,96 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestPrinter_PrintSuppressions(t *testing.T) {
+	suppressions := []result.Suppression{
+		{
+			Kind:    result.SuppressionNolint,
+			Name:    "pkg/a/a.go:12",
+			Reason:  "JIRA-123",
+			Linter:  "errcheck",
+			Package: "example.com/pkg/a",
+			Count:   2,
+		},
+		{
+			Kind:    result.SuppressionDefaultExclusion,
+			Name:    "EXC0001",
+			Linter:  "errcheck",
+			Package: "example.com/pkg/b",
+			Count:   1,
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		format   string
+		expected string
+	}{
+		{
+			desc:   "text",
+			format: SuppressionsFormatText,
+			expected: `3 suppressed issues.
+
+By kind:
+* default-exclusion:  1
+* nolint:             2
+
+By linter:
+* errcheck:  3
+
+By package:
+* example.com/pkg/a:  2
+* example.com/pkg/b:  1
+
+By reason:
+* JIRA-123:  2
+
+Suppressions:
+nolint             pkg/a/a.go:12  errcheck  example.com/pkg/a  2  JIRA-123
+default-exclusion  EXC0001        errcheck  example.com/pkg/b  1
+`,
+		},
+		{
+			desc:   "json",
+			format: SuppressionsFormatJSON,
+			expected: `{"Total":3,"ByKind":{"default-exclusion":1,"nolint":2},"ByLinter":{"errcheck":3},"ByPackage":{"example.com/pkg/a":2,"example.com/pkg/b":1},"ByReason":{"JIRA-123":2},"Suppressions":[{"Kind":"nolint","Name":"pkg/a/a.go:12","Reason":"JIRA-123","Linter":"errcheck","Package":"example.com/pkg/a","Count":2},{"Kind":"default-exclusion","Name":"EXC0001","Linter":"errcheck","Package":"example.com/pkg/b","Count":1}]}
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := NewPrinter(logutils.NewStderrLog("skip"), &config.Output{}, &report.Data{})
+			require.NoError(t, err)
+
+			var stdOutBuffer bytes.Buffer
+			p.stdOut = &stdOutBuffer
+
+			err = p.PrintSuppressions(suppressions, &config.OutputFormat{Format: test.format})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, stdOutBuffer.String())
+		})
+	}
+}
+
+func TestPrinter_PrintSuppressions_unknownFormat(t *testing.T) {
+	p, err := NewPrinter(logutils.NewStderrLog("skip"), &config.Output{}, &report.Data{})
+	require.NoError(t, err)
+
+	err = p.PrintSuppressions(nil, &config.OutputFormat{Format: "xml"})
+	require.EqualError(t, err, `unknown suppressions format "xml"`)
+}
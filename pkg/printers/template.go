@@ -0,0 +1,121 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// templateFuncs are the helpers available inside the user-defined templates.
+var templateFuncs = template.FuncMap{
+	"relPath":  templateRelPath,
+	"json":     templateJSON,
+	"severity": templateSeverity,
+	"lower":    strings.ToLower,
+	"upper":    strings.ToUpper,
+	"trim":     strings.TrimSpace,
+	"join":     strings.Join,
+	"replace":  strings.ReplaceAll,
+}
+
+// TemplateData is the data rendered by the user-defined templates.
+type TemplateData struct {
+	Issues []result.Issue
+	Report *report.Data
+}
+
+// Template prints the issues with a user-defined text/template.
+type Template struct {
+	rd   *report.Data
+	tmpl *template.Template
+	w    io.Writer
+}
+
+func NewTemplate(rd *report.Data, tmpl *template.Template, w io.Writer) *Template {
+	return &Template{
+		rd:   rd,
+		tmpl: tmpl,
+		w:    w,
+	}
+}
+
+// ParseTemplateFile parses a user-defined template, with the helpers of the template output format.
+func ParseTemplateFile(path string) (*template.Template, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read the template: %w", err)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Funcs(templateFuncs).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("can't parse the template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func (p Template) Print(issues []result.Issue) error {
+	data := TemplateData{
+		Issues: issues,
+		Report: p.rd,
+	}
+
+	if data.Issues == nil {
+		data.Issues = []result.Issue{}
+	}
+
+	return p.tmpl.Execute(p.w, data)
+}
+
+// templateRelPath returns the path relative to the working directory, or the path itself if it can't be computed.
+func templateRelPath(path string) string {
+	rel, err := fsutils.ShortestRelPath(path, "")
+	if err != nil {
+		return path
+	}
+
+	return rel
+}
+
+// templateJSON encodes the value in JSON: a string is quoted and escaped.
+func templateJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// templateSeverity maps a severity with the pairs "severity=value",
+// a "*=value" pair is used when no other pair matches.
+// The severity is returned unchanged if nothing matches.
+//
+// Example: {{ severity .Severity "error=E" "warning=W" "*=I" }}.
+func templateSeverity(severity string, pairs ...string) (string, error) {
+	fallback := severity
+
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return "", fmt.Errorf("invalid severity mapping %q, the format is 'severity=value'", pair)
+		}
+
+		switch {
+		case key == "*":
+			fallback = value
+		case strings.EqualFold(key, severity):
+			return value, nil
+		}
+	}
+
+	return fallback, nil
+}
@@ -0,0 +1,119 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestTemplate_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       `some "issue"`,
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "low",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+	}
+
+	rd := &report.Data{
+		Linters: []report.LinterData{
+			{Name: "linter-a"},
+			{Name: "linter-b"},
+		},
+	}
+
+	tmpl, err := ParseTemplateFile(filepath.Join("testdata", "template.tmpl"))
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+
+	printer := NewTemplate(rd, tmpl, buf)
+
+	err = printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := `path/to/filea.go:10 [W] LINTER-A "some \"issue\""
+path/to/fileb.go:300 [I] LINTER-B "another issue"
+2 issues, 2 linters
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestParseTemplateFile_error(t *testing.T) {
+	_, err := ParseTemplateFile(filepath.Join("testdata", "missing.tmpl"))
+	require.ErrorContains(t, err, "can't read the template")
+}
+
+func Test_templateSeverity(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		severity string
+		pairs    []string
+		expected string
+	}{
+		{
+			desc:     "no pairs",
+			severity: "error",
+			expected: "error",
+		},
+		{
+			desc:     "match",
+			severity: "Error",
+			pairs:    []string{"warning=W", "error=E"},
+			expected: "E",
+		},
+		{
+			desc:     "no match",
+			severity: "low",
+			pairs:    []string{"error=E"},
+			expected: "low",
+		},
+		{
+			desc:     "fallback",
+			severity: "",
+			pairs:    []string{"error=E", "*=I"},
+			expected: "I",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			severity, err := templateSeverity(test.severity, test.pairs...)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, severity)
+		})
+	}
+}
+
+func Test_templateSeverity_error(t *testing.T) {
+	_, err := templateSeverity("error", "error")
+	require.EqualError(t, err, `invalid severity mapping "error", the format is 'severity=value'`)
+}
@@ -58,7 +58,12 @@ func (p *Text) Print(issues []result.Issue) error {
 func (p *Text) printIssue(issue *result.Issue) {
 	text := p.SprintfColored(color.FgRed, "%s", strings.TrimSpace(issue.Text))
 	if p.printLinterName {
-		text += fmt.Sprintf(" (%s)", issue.FromLinter)
+		linters := []string{issue.FromLinter}
+		for _, alias := range issue.Aliases {
+			linters = append(linters, alias.FromLinter)
+		}
+
+		text += fmt.Sprintf(" (%s)", strings.Join(linters, ", "))
 	}
 	pos := p.SprintfColored(color.Bold, "%s:%d", issue.FilePath(), issue.Line())
 	if issue.Pos.Column != 0 {
@@ -37,6 +37,9 @@ func TestText_Print(t *testing.T) {
 			FromLinter: "linter-b",
 			Severity:   "error",
 			Text:       "another issue",
+			Aliases: []result.Alias{
+				{FromLinter: "linter-c", Text: "same issue"},
+			},
 			SourceLines: []string{
 				"func foo() {",
 				"\tfmt.Println(\"bar\")",
@@ -64,7 +67,7 @@ func TestText_Print(t *testing.T) {
 			printLinterName: true,
 			useColors:       false,
 			expected: `path/to/filea.go:10:4: some issue (linter-a)
-path/to/fileb.go:300:9: another issue (linter-b)
+path/to/fileb.go:300:9: another issue (linter-b, linter-c)
 func foo() {
 	fmt.Println("bar")
 }
@@ -76,7 +79,7 @@ func foo() {
 			printLinterName: true,
 			useColors:       false,
 			expected: `path/to/filea.go:10:4: some issue (linter-a)
-path/to/fileb.go:300:9: another issue (linter-b)
+path/to/fileb.go:300:9: another issue (linter-b, linter-c)
 `,
 		},
 		{
@@ -96,7 +99,7 @@ func foo() {
 			printIssuedLine: true,
 			printLinterName: true,
 			useColors:       true,
-			expected:        "\x1b[1mpath/to/filea.go:10\x1b[22m:4: \x1b[31msome issue\x1b[0m (linter-a)\n\x1b[1mpath/to/fileb.go:300\x1b[22m:9: \x1b[31manother issue\x1b[0m (linter-b)\nfunc foo() {\n\tfmt.Println(\"bar\")\n}\n",
+			expected:        "\x1b[1mpath/to/filea.go:10\x1b[22m:4: \x1b[31msome issue\x1b[0m (linter-a)\n\x1b[1mpath/to/fileb.go:300\x1b[22m:9: \x1b[31manother issue\x1b[0m (linter-b, linter-c)\nfunc foo() {\n\tfmt.Println(\"bar\")\n}\n",
 		},
 		{
 			desc:            "disable all options",
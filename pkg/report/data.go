@@ -1,5 +1,7 @@
 package report
 
+import "github.com/golangci/golangci-lint/pkg/result"
+
 type Warning struct {
 	Tag  string `json:",omitempty"`
 	Text string
@@ -7,19 +9,30 @@ type Warning struct {
 
 type LinterData struct {
 	Name             string
-	Enabled          bool `json:",omitempty"`
-	EnabledByDefault bool `json:",omitempty"`
+	Desc             string `json:",omitempty"`
+	URL              string `json:",omitempty"`
+	Enabled          bool   `json:",omitempty"`
+	EnabledByDefault bool   `json:",omitempty"`
 }
 
 type Data struct {
-	Warnings []Warning    `json:",omitempty"`
-	Linters  []LinterData `json:",omitempty"`
-	Error    string       `json:",omitempty"`
+	// Version is the version of golangci-lint.
+	Version string `json:",omitempty"`
+
+	Warnings     []Warning            `json:",omitempty"`
+	Linters      []LinterData         `json:",omitempty"`
+	HiddenIssues []result.HiddenIssue `json:",omitempty"`
+	Error        string               `json:",omitempty"`
+
+	// ExitCode is the exit code of the run, known before the results are printed.
+	ExitCode int `json:",omitempty"`
 }
 
-func (d *Data) AddLinter(name string, enabled, enabledByDefault bool) {
+func (d *Data) AddLinter(name, desc, url string, enabled, enabledByDefault bool) {
 	d.Linters = append(d.Linters, LinterData{
 		Name:             name,
+		Desc:             desc,
+		URL:              url,
 		Enabled:          enabled,
 		EnabledByDefault: enabledByDefault,
 	})
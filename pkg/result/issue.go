@@ -24,10 +24,22 @@ type InlineFix struct {
 	NewString string
 }
 
+// Alias is an issue merged into an equivalent issue.
+type Alias struct {
+	FromLinter string
+	RuleID     string `json:",omitempty"`
+	Text       string
+	Severity   string `json:",omitempty"`
+}
+
 type Issue struct {
 	FromLinter string
 	Text       string
 
+	// RuleID is the identifier of the rule (or analyzer) of the linter that reported the issue (ex: G304, SA1019).
+	// It's empty when the linter has no concept of rules.
+	RuleID string `json:",omitempty"`
+
 	Severity string
 
 	// Source lines of a code with the issue to show
@@ -46,9 +58,20 @@ type Issue struct {
 	// HunkPos is used only when golangci-lint is run over a diff
 	HunkPos int `json:",omitempty"`
 
+	// IsNew is true when the issue is inside the changes, it's used only when golangci-lint is run over a diff.
+	IsNew bool `json:"-"`
+
 	// If we are expecting a nolint (because this is from nolintlint), record the expected linter
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
+	ExpectedNoLintRule   string `json:",omitempty"`
+
+	// Aliases are the equivalent issues reported on the same line by other linters, and merged into this issue.
+	Aliases []Alias `json:",omitempty"`
+
+	// HiddenBy is the mechanism that hid the issue (nolint directive, exclude rule, etc.).
+	// It's set by the processors on the issues they drop, to explain the hidden issues.
+	HiddenBy *Suppression `json:"-"`
 }
 
 func (i *Issue) FilePath() string {
@@ -6,8 +6,10 @@ import (
 	"go/token"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -37,6 +39,13 @@ var _ Processor = (*AutogeneratedExclude)(nil)
 
 type fileSummary struct {
 	generated bool
+	reason    string // the marker or the pattern that identifies the generated file.
+}
+
+// generatedFilesPattern is a pattern of `generated-files`, with the same syntax as the globs of the linters settings overrides.
+type generatedFilesPattern struct {
+	glob    string
+	pattern *regexp.Regexp
 }
 
 type AutogeneratedExclude struct {
@@ -45,16 +54,49 @@ type AutogeneratedExclude struct {
 	mode          string
 	strictPattern *regexp.Regexp
 
+	// Custom detection of the generated files.
+	markers []*regexp.Regexp
+	files   []generatedFilesPattern
+
+	// The linters that report issues even inside the generated files.
+	lintGenerated []string
+
 	fileSummaryCache map[string]*fileSummary
+
+	suppressions suppressionCounter
 }
 
-func NewAutogeneratedExclude(mode string) *AutogeneratedExclude {
+func NewAutogeneratedExclude(cfg *config.Issues) (*AutogeneratedExclude, error) {
+	var markers []*regexp.Regexp
+	for _, m := range cfg.GeneratedMarkers {
+		marker, err := regexp.Compile(m)
+		if err != nil {
+			return nil, fmt.Errorf("can't compile regexp %q: %w", m, err)
+		}
+
+		markers = append(markers, marker)
+	}
+
+	var files []generatedFilesPattern
+	for _, glob := range cfg.GeneratedFiles {
+		pattern, err := regexp.Compile(config.GlobToRegex(glob))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", glob, err)
+		}
+
+		files = append(files, generatedFilesPattern{glob: glob, pattern: pattern})
+	}
+
 	return &AutogeneratedExclude{
 		debugf:           logutils.Debug(logutils.DebugKeyAutogenExclude),
-		mode:             mode,
+		mode:             cfg.ExcludeGenerated,
 		strictPattern:    regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`),
+		markers:          markers,
+		files:            files,
+		lintGenerated:    cfg.LintGenerated,
 		fileSummaryCache: map[string]*fileSummary{},
-	}
+		suppressions:     suppressionCounter{},
+	}, nil
 }
 
 func (*AutogeneratedExclude) Name() string {
@@ -71,45 +113,133 @@ func (p *AutogeneratedExclude) Process(issues []result.Issue) ([]result.Issue, e
 
 func (*AutogeneratedExclude) Finish() {}
 
+// Suppressions returns the issues excluded because they are inside generated files.
+func (p *AutogeneratedExclude) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
 func (p *AutogeneratedExclude) shouldPassIssue(issue *result.Issue) (bool, error) {
-	if filepath.Base(issue.FilePath()) == "go.mod" {
+	if filepath.Base(issue.FilePath()) == goModFileName {
 		return true, nil
 	}
 
+	if slices.Contains(p.lintGenerated, issue.FromLinter) {
+		return true, nil
+	}
+
+	fs, err := p.getFileSummary(issue)
+	if err != nil {
+		return false, err
+	}
+
+	if fs.generated {
+		p.suppressions.add(result.SuppressionGenerated, issue.FilePath(), fs.reason, issue)
+	}
+
+	// don't report issues for autogenerated files
+	return !fs.generated, nil
+}
+
+func (p *AutogeneratedExclude) getFileSummary(issue *result.Issue) (*fileSummary, error) {
 	// The file is already known.
 	fs := p.fileSummaryCache[issue.FilePath()]
 	if fs != nil {
-		return !fs.generated, nil
+		return fs, nil
 	}
 
 	fs = &fileSummary{}
 	p.fileSummaryCache[issue.FilePath()] = fs
 
+	if pattern, ok := p.matchGeneratedFiles(issue.FilePath()); ok {
+		p.debugf("file %q matches the pattern %q: file is generated", issue.FilePath(), pattern)
+
+		fs.generated = true
+		fs.reason = fmt.Sprintf("pattern %q", pattern)
+
+		return fs, nil
+	}
+
+	var marker string
+
 	if p.mode == AutogeneratedModeStrict {
 		var err error
 		fs.generated, err = p.isGeneratedFileStrict(issue.FilePath())
 		if err != nil {
-			return false, fmt.Errorf("failed to get doc (strict) of file %s: %w", issue.FilePath(), err)
+			return nil, fmt.Errorf("failed to get doc (strict) of file %s: %w", issue.FilePath(), err)
 		}
+
+		marker = p.strictPattern.String()
 	} else {
 		doc, err := getComments(issue.FilePath())
 		if err != nil {
-			return false, fmt.Errorf("failed to get doc (lax) of file %s: %w", issue.FilePath(), err)
+			return nil, fmt.Errorf("failed to get doc (lax) of file %s: %w", issue.FilePath(), err)
 		}
 
-		fs.generated = p.isGeneratedFileLax(doc)
+		marker, fs.generated = p.isGeneratedFileLax(doc)
+	}
+
+	if !fs.generated && len(p.markers) > 0 {
+		var err error
+		marker, fs.generated, err = p.isGeneratedFileByMarkers(issue.FilePath())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get header comments of file %s: %w", issue.FilePath(), err)
+		}
+	}
+
+	if fs.generated {
+		fs.reason = fmt.Sprintf("marker %q", marker)
 	}
 
 	p.debugf("file %q is generated: %t", issue.FilePath(), fs.generated)
 
-	// don't report issues for autogenerated files
-	return !fs.generated, nil
+	return fs, nil
+}
+
+// matchGeneratedFiles returns the first pattern of `generated-files` matching the path.
+// A pattern matches the end of the path: `*.pb.go` matches the file names, `**` matches any number of directories.
+func (p *AutogeneratedExclude) matchGeneratedFiles(filePath string) (string, bool) {
+	filePath = filepath.ToSlash(filePath)
+
+	for _, f := range p.files {
+		if f.pattern.MatchString(filePath) {
+			return f.glob, true
+		}
+	}
+
+	return "", false
+}
+
+// isGeneratedFileByMarkers reports whether a line of the header comments (the comments before the package clause)
+// matches one of the `generated-markers`, and returns the marker.
+func (p *AutogeneratedExclude) isGeneratedFileByMarkers(filePath string) (marker string, generated bool, err error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filePath, nil, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to parse file: %w", err)
+	}
+
+	for _, comment := range file.Comments {
+		if comment.Pos() > file.Package {
+			break
+		}
+
+		for _, line := range comment.List {
+			for _, m := range p.markers {
+				if m.MatchString(line.Text) {
+					p.debugf("doc contains the marker %q: file is generated", m)
+
+					return m.String(), true, nil
+				}
+			}
+		}
+	}
+
+	return "", false, nil
 }
 
-// isGeneratedFileLax reports whether the source file is generated code.
+// isGeneratedFileLax reports whether the source file is generated code, and returns the marker found.
 // The function uses a bit laxer rules than isGeneratedFileStrict to match more generated code.
 // See https://github.com/golangci/golangci-lint/issues/48 and https://github.com/golangci/golangci-lint/issues/72.
-func (p *AutogeneratedExclude) isGeneratedFileLax(doc string) bool {
+func (p *AutogeneratedExclude) isGeneratedFileLax(doc string) (string, bool) {
 	markers := []string{genCodeGenerated, genDoNotEdit, genAutoFile, genSwaggerCodegen}
 
 	doc = strings.ToLower(doc)
@@ -118,13 +248,13 @@ func (p *AutogeneratedExclude) isGeneratedFileLax(doc string) bool {
 		if strings.Contains(doc, marker) {
 			p.debugf("doc contains marker %q: file is generated", marker)
 
-			return true
+			return marker, true
 		}
 	}
 
 	p.debugf("doc of len %d doesn't contain any of markers: %s", len(doc), markers)
 
-	return false
+	return "", false
 }
 
 // isGeneratedFileStrict returns true if the source file has a line that matches the regular expression:
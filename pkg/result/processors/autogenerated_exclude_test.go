@@ -10,11 +10,21 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
+func newTestAutogeneratedExclude(t *testing.T, cfg *config.Issues) *AutogeneratedExclude {
+	t.Helper()
+
+	p, err := NewAutogeneratedExclude(cfg)
+	require.NoError(t, err)
+
+	return p
+}
+
 func TestAutogeneratedExclude_isGeneratedFileLax_generated(t *testing.T) {
-	p := NewAutogeneratedExclude(AutogeneratedModeLax)
+	p := newTestAutogeneratedExclude(t, &config.Issues{ExcludeGenerated: AutogeneratedModeLax})
 
 	comments := []string{
 		`	// generated by stringer -type Pill pill.go; DO NOT EDIT`,
@@ -49,14 +59,14 @@ func TestAutogeneratedExclude_isGeneratedFileLax_generated(t *testing.T) {
 		t.Run(comment, func(t *testing.T) {
 			t.Parallel()
 
-			generated := p.isGeneratedFileLax(comment)
+			_, generated := p.isGeneratedFileLax(comment)
 			assert.True(t, generated)
 		})
 	}
 }
 
 func TestAutogeneratedExclude_isGeneratedFileLax_nonGenerated(t *testing.T) {
-	p := NewAutogeneratedExclude(AutogeneratedModeLax)
+	p := newTestAutogeneratedExclude(t, &config.Issues{ExcludeGenerated: AutogeneratedModeLax})
 
 	comments := []string{
 		"code not generated by",
@@ -67,14 +77,14 @@ func TestAutogeneratedExclude_isGeneratedFileLax_nonGenerated(t *testing.T) {
 		t.Run(comment, func(t *testing.T) {
 			t.Parallel()
 
-			generated := p.isGeneratedFileLax(comment)
+			_, generated := p.isGeneratedFileLax(comment)
 			assert.False(t, generated)
 		})
 	}
 }
 
 func TestAutogeneratedExclude_isGeneratedFileStrict(t *testing.T) {
-	p := NewAutogeneratedExclude(AutogeneratedModeStrict)
+	p := newTestAutogeneratedExclude(t, &config.Issues{ExcludeGenerated: AutogeneratedModeStrict})
 
 	testCases := []struct {
 		desc     string
@@ -184,7 +194,7 @@ func Test_shouldPassIssue(t *testing.T) {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			p := NewAutogeneratedExclude(test.mode)
+			p := newTestAutogeneratedExclude(t, &config.Issues{ExcludeGenerated: test.mode})
 
 			pass, err := p.shouldPassIssue(test.issue)
 			require.NoError(t, err)
@@ -194,6 +204,131 @@ func Test_shouldPassIssue(t *testing.T) {
 	}
 }
 
+func Test_shouldPassIssue_custom(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		cfg    *config.Issues
+		issue  *result.Issue
+		assert assert.BoolAssertionFunc
+	}{
+		{
+			desc: "marker",
+			cfg: &config.Issues{
+				ExcludeGenerated: AutogeneratedModeStrict,
+				GeneratedMarkers: []string{`^// This file is produced by internal-gen`},
+			},
+			issue: &result.Issue{
+				FromLinter: "example",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("testdata/autogen_custom_marker.go"),
+				},
+			},
+			assert: assert.False,
+		},
+		{
+			desc: "no marker",
+			cfg: &config.Issues{
+				ExcludeGenerated: AutogeneratedModeLax,
+			},
+			issue: &result.Issue{
+				FromLinter: "example",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("testdata/autogen_custom_marker.go"),
+				},
+			},
+			assert: assert.True,
+		},
+		{
+			desc: "file name pattern",
+			cfg: &config.Issues{
+				ExcludeGenerated: AutogeneratedModeLax,
+				GeneratedFiles:   []string{"*_custom_marker.go"},
+			},
+			issue: &result.Issue{
+				FromLinter: "example",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("testdata/autogen_custom_marker.go"),
+				},
+			},
+			assert: assert.False,
+		},
+		{
+			desc: "path pattern",
+			cfg: &config.Issues{
+				ExcludeGenerated: AutogeneratedModeLax,
+				GeneratedFiles:   []string{"testdata/*.go"},
+			},
+			issue: &result.Issue{
+				FromLinter: "example",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("testdata/no-existing.go"),
+				},
+			},
+			assert: assert.False,
+		},
+		{
+			desc: "recursive pattern",
+			cfg: &config.Issues{
+				ExcludeGenerated: AutogeneratedModeLax,
+				GeneratedFiles:   []string{"**/autogen_*.go"},
+			},
+			issue: &result.Issue{
+				FromLinter: "example",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("testdata/autogen_custom_marker.go"),
+				},
+			},
+			assert: assert.False,
+		},
+		{
+			desc: "path pattern not matching",
+			cfg: &config.Issues{
+				ExcludeGenerated: AutogeneratedModeLax,
+				GeneratedFiles:   []string{"internal/*.go"},
+			},
+			issue: &result.Issue{
+				FromLinter: "example",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("testdata/autogen_custom_marker.go"),
+				},
+			},
+			assert: assert.True,
+		},
+		{
+			desc: "linter allowed to lint generated files",
+			cfg: &config.Issues{
+				ExcludeGenerated: AutogeneratedModeLax,
+				LintGenerated:    []string{"gosec"},
+			},
+			issue: &result.Issue{
+				FromLinter: "gosec",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("testdata/autogen_go_strict_invalid.go"),
+				},
+			},
+			assert: assert.True,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			p := newTestAutogeneratedExclude(t, test.cfg)
+
+			pass, err := p.shouldPassIssue(test.issue)
+			require.NoError(t, err)
+
+			test.assert(t, pass)
+		})
+	}
+}
+
+func TestNewAutogeneratedExclude_error(t *testing.T) {
+	_, err := NewAutogeneratedExclude(&config.Issues{GeneratedMarkers: []string{"("}})
+	require.Error(t, err)
+}
+
 func Test_shouldPassIssue_error(t *testing.T) {
 	notFoundMsg := "no such file or directory"
 	if runtime.GOOS == "windows" {
@@ -236,7 +371,7 @@ func Test_shouldPassIssue_error(t *testing.T) {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			p := NewAutogeneratedExclude(test.mode)
+			p := newTestAutogeneratedExclude(t, &config.Issues{ExcludeGenerated: test.mode})
 
 			pass, err := p.shouldPassIssue(test.issue)
 
@@ -2,8 +2,11 @@ package processors
 
 import (
 	"regexp"
+	"time"
 
+	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -15,14 +18,17 @@ type baseRule struct {
 	source     *regexp.Regexp
 	path       *regexp.Regexp
 	pathExcept *regexp.Regexp
+	symbol     *regexp.Regexp
+	pkg        *regexp.Regexp
 	linters    []string
 }
 
 func (r *baseRule) isEmpty() bool {
-	return r.text == nil && r.source == nil && r.path == nil && r.pathExcept == nil && len(r.linters) == 0
+	return r.text == nil && r.source == nil && r.path == nil && r.pathExcept == nil &&
+		r.symbol == nil && r.pkg == nil && len(r.linters) == 0
 }
 
-func (r *baseRule) match(issue *result.Issue, files *fsutils.Files, log logutils.Log) bool {
+func (r *baseRule) match(issue *result.Issue, files *fsutils.Files, symbols *SymbolIndex, log logutils.Log) bool {
 	if r.isEmpty() {
 		return false
 	}
@@ -38,11 +44,23 @@ func (r *baseRule) match(issue *result.Issue, files *fsutils.Files, log logutils
 	if len(r.linters) != 0 && !r.matchLinter(issue) {
 		return false
 	}
+	// Without package information, the import path is unknown: the directory of the file is not an import path.
+	if r.pkg != nil && (issue.Pkg == nil || !r.pkg.MatchString(issue.Pkg.PkgPath)) {
+		return false
+	}
 
 	// the most heavyweight checking last
+	return r.matchContent(issue, files, symbols, log)
+}
+
+// matchContent checks the conditions that require to read the file of the issue.
+func (r *baseRule) matchContent(issue *result.Issue, files *fsutils.Files, symbols *SymbolIndex, log logutils.Log) bool {
 	if r.source != nil && !r.matchSource(issue, files.LineCache, log) {
 		return false
 	}
+	if r.symbol != nil && !r.symbol.MatchString(symbols.lookup(issue)) {
+		return false
+	}
 
 	return true
 }
@@ -57,6 +75,18 @@ func (r *baseRule) matchLinter(issue *result.Issue) bool {
 	return false
 }
 
+// lintersEnabled reports whether all the linters of the rule are enabled:
+// a rule about a disabled linter can't match any issue, but it's not useless.
+func (r *baseRule) lintersEnabled(enabledLinters map[string]*linter.Config) bool {
+	for _, name := range r.linters {
+		if enabledLinters[name] == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (r *baseRule) matchSource(issue *result.Issue, lineCache *fsutils.LineCache, log logutils.Log) bool {
 	sourceLine, errSourceLine := lineCache.GetLine(issue.FilePath(), issue.Line())
 	if errSourceLine != nil {
@@ -66,3 +96,22 @@ func (r *baseRule) matchSource(issue *result.Issue, lineCache *fsutils.LineCache
 
 	return r.source.MatchString(sourceLine)
 }
+
+// isRuleExpired reports whether the rule is expired, and logs a warning if it is.
+func isRuleExpired(log logutils.Log, name string, rule *config.BaseRule, now time.Time) bool {
+	if !rule.IsExpired(now) {
+		return false
+	}
+
+	if log == nil {
+		return true
+	}
+
+	if rule.Reason != "" {
+		log.Warnf("The rule %s expired on %s, it's no longer applied (reason: %s).", name, rule.Expires, rule.Reason)
+	} else {
+		log.Warnf("The rule %s expired on %s, it's no longer applied.", name, rule.Expires)
+	}
+
+	return true
+}
@@ -0,0 +1,207 @@
+package processors
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*DedupEquivalent)(nil)
+
+// defaultEquivalentIssues are the groups of rules of different linters reporting the same defects.
+// Inside a group, when the severities are the same, the first rule is preferred.
+var defaultEquivalentIssues = [][]string{
+	{"errcheck", "gosec(G104)"},
+	{"ineffassign", "staticcheck(SA4006)"},
+	{"govet(printf)", "staticcheck(SA5009)"},
+	{"govet(unreachable)", "revive(unreachable-code)"},
+	{"staticcheck(SA9003)", "revive(empty-block)"},
+	{"stylecheck(ST1000)", "revive(package-comments)"},
+	{"stylecheck(ST1003)", "revive(var-naming)"},
+	{"stylecheck(ST1005)", "revive(error-strings)"},
+	{"stylecheck(ST1012)", "revive(error-naming)"},
+	{"stylecheck(ST1016)", "revive(receiver-naming)"},
+}
+
+// equivalentRule is a rule of an equivalence group.
+type equivalentRule struct {
+	group    int
+	priority int
+}
+
+type equivalentKey struct {
+	file  string
+	line  int
+	group int
+}
+
+// DedupEquivalent merges the equivalent issues reported on the same line by different linters.
+// The issue with the highest severity is kept, the others are listed as its aliases.
+type DedupEquivalent struct {
+	enabled bool
+
+	// The keys are `linter` or `linter(rule)`.
+	rules map[string]equivalentRule
+
+	suppressions suppressionCounter
+}
+
+func NewDedupEquivalent(cfg *config.Issues) *DedupEquivalent {
+	p := &DedupEquivalent{
+		enabled:      cfg.MergeEquivalentIssues,
+		rules:        map[string]equivalentRule{},
+		suppressions: suppressionCounter{},
+	}
+
+	// The user-defined groups take precedence over the built-in groups.
+	for i, group := range slices.Concat(cfg.EquivalentIssues, defaultEquivalentIssues) {
+		for j, item := range group {
+			linterName, rule := parseNolintItem(item)
+
+			key := equivalentRuleKey(linterName, rule)
+			if _, ok := p.rules[key]; ok {
+				continue
+			}
+
+			p.rules[key] = equivalentRule{group: i, priority: j}
+		}
+	}
+
+	return p
+}
+
+func (*DedupEquivalent) Name() string {
+	return "dedup_equivalent"
+}
+
+func (p *DedupEquivalent) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled {
+		return issues, nil
+	}
+
+	buckets := map[equivalentKey][]int{}
+
+	for i := range issues {
+		rule, ok := p.lookup(&issues[i])
+		if !ok {
+			continue
+		}
+
+		key := equivalentKey{file: issues[i].FilePath(), line: issues[i].Line(), group: rule.group}
+		buckets[key] = append(buckets[key], i)
+	}
+
+	merged := map[int]bool{}
+
+	for _, indexes := range buckets {
+		if len(indexes) < 2 || !fromDistinctLinters(issues, indexes) {
+			// Several issues of the same linter on the same line are not duplicates:
+			// the equivalent issues can't be paired.
+			continue
+		}
+
+		kept := indexes[0]
+		for _, i := range indexes[1:] {
+			if p.prefer(&issues[i], &issues[kept]) {
+				kept = i
+			}
+		}
+
+		for _, i := range indexes {
+			if i == kept {
+				continue
+			}
+
+			issues[kept].Aliases = append(issues[kept].Aliases, result.Alias{
+				FromLinter: issues[i].FromLinter,
+				RuleID:     issues[i].RuleID,
+				Text:       issues[i].Text,
+				Severity:   issues[i].Severity,
+			})
+
+			p.suppressions.add(result.SuppressionEquivalent, issueRuleName(&issues[kept]), "", &issues[i])
+
+			merged[i] = true
+		}
+	}
+
+	if len(merged) == 0 {
+		return issues, nil
+	}
+
+	retIssues := make([]result.Issue, 0, len(issues)-len(merged))
+	for i := range issues {
+		if !merged[i] {
+			retIssues = append(retIssues, issues[i])
+		}
+	}
+
+	return retIssues, nil
+}
+
+func (*DedupEquivalent) Finish() {}
+
+// Suppressions returns the issues merged into an equivalent issue.
+func (p *DedupEquivalent) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
+// lookup returns the rule of the issue: the rule of the linter (`linter(rule)`), or else the linter.
+func (p *DedupEquivalent) lookup(issue *result.Issue) (equivalentRule, bool) {
+	if issue.RuleID != "" {
+		if rule, ok := p.rules[equivalentRuleKey(issue.FromLinter, issue.RuleID)]; ok {
+			return rule, true
+		}
+	}
+
+	rule, ok := p.rules[equivalentRuleKey(issue.FromLinter, "")]
+
+	return rule, ok
+}
+
+// prefer reports whether the issue a should be kept instead of the issue b:
+// the highest severity wins, then the first rule of the group.
+func (p *DedupEquivalent) prefer(a, b *result.Issue) bool {
+	rankA, rankB := config.SeverityRank(a.Severity), config.SeverityRank(b.Severity)
+	if rankA != rankB {
+		return rankA > rankB
+	}
+
+	ruleA, _ := p.lookup(a)
+	ruleB, _ := p.lookup(b)
+
+	return ruleA.priority < ruleB.priority
+}
+
+func fromDistinctLinters(issues []result.Issue, indexes []int) bool {
+	linters := map[string]bool{}
+
+	for _, i := range indexes {
+		if linters[issues[i].FromLinter] {
+			return false
+		}
+
+		linters[issues[i].FromLinter] = true
+	}
+
+	return true
+}
+
+func equivalentRuleKey(linterName, rule string) string {
+	if rule == "" {
+		return strings.ToLower(linterName)
+	}
+
+	return ruleKey(strings.ToLower(linterName), rule)
+}
+
+// issueRuleName returns `linter` or `linter(rule)`.
+func issueRuleName(issue *result.Issue) string {
+	if issue.RuleID == "" {
+		return issue.FromLinter
+	}
+
+	return issue.FromLinter + "(" + issue.RuleID + ")"
+}
@@ -0,0 +1,89 @@
+package processors
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newEquivalentIssue(line int, linter, ruleID, severity string) result.Issue {
+	return result.Issue{
+		FromLinter: linter,
+		RuleID:     ruleID,
+		Text:       linter + " issue",
+		Severity:   severity,
+		Pos:        token.Position{Filename: "a.go", Line: line},
+	}
+}
+
+func TestDedupEquivalent(t *testing.T) {
+	p := NewDedupEquivalent(&config.Issues{
+		MergeEquivalentIssues: true,
+		EquivalentIssues:      [][]string{{"bodyclose", "revive(unhandled-error)"}},
+	})
+
+	issues := []result.Issue{
+		newEquivalentIssue(1, "gosec", "G104", "low"),
+		newEquivalentIssue(1, "errcheck", "", ""),
+		newEquivalentIssue(2, "errcheck", "", ""),
+		newEquivalentIssue(2, "gosec", "G104", ""),
+		newEquivalentIssue(3, "errcheck", "", ""),
+		newEquivalentIssue(3, "gosec", "G304", ""),
+		newEquivalentIssue(4, "revive", "unhandled-error", ""),
+		newEquivalentIssue(4, "bodyclose", "", ""),
+		newEquivalentIssue(5, "errcheck", "", ""),
+		newEquivalentIssue(5, "errcheck", "", ""),
+		newEquivalentIssue(5, "gosec", "G104", ""),
+	}
+
+	expected := []result.Issue{
+		// the highest severity is kept
+		newEquivalentIssue(1, "gosec", "G104", "low"),
+		// with the same severity, the first rule of the group is kept
+		newEquivalentIssue(2, "errcheck", "", ""),
+		// not equivalent rules
+		newEquivalentIssue(3, "errcheck", "", ""),
+		newEquivalentIssue(3, "gosec", "G304", ""),
+		// user-defined group
+		newEquivalentIssue(4, "bodyclose", "", ""),
+		// several issues from the same linter can't be paired
+		newEquivalentIssue(5, "errcheck", "", ""),
+		newEquivalentIssue(5, "errcheck", "", ""),
+		newEquivalentIssue(5, "gosec", "G104", ""),
+	}
+
+	expected[0].Aliases = []result.Alias{{FromLinter: "errcheck", Text: "errcheck issue"}}
+	expected[1].Aliases = []result.Alias{{FromLinter: "gosec", RuleID: "G104", Text: "gosec issue"}}
+	expected[4].Aliases = []result.Alias{{FromLinter: "revive", RuleID: "unhandled-error", Text: "revive issue"}}
+
+	processed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	for i := range processed {
+		processed[i].HiddenBy = nil
+	}
+
+	assert.Equal(t, expected, processed)
+
+	expectedSuppressions := []result.Suppression{
+		{Kind: result.SuppressionEquivalent, Name: "bodyclose", Linter: "revive", Count: 1},
+		{Kind: result.SuppressionEquivalent, Name: "errcheck", Linter: "gosec", Count: 1},
+		{Kind: result.SuppressionEquivalent, Name: "gosec(G104)", Linter: "errcheck", Count: 1},
+	}
+
+	assert.Equal(t, expectedSuppressions, p.Suppressions())
+}
+
+func TestDedupEquivalent_disabled(t *testing.T) {
+	p := NewDedupEquivalent(&config.Issues{})
+
+	processAssertSame(t, p,
+		newEquivalentIssue(1, "errcheck", "", ""),
+		newEquivalentIssue(1, "gosec", "G104", ""),
+	)
+}
@@ -19,66 +19,142 @@ var _ Processor = (*Diff)(nil)
 
 type Diff struct {
 	onlyNew       bool
+	markOnly      bool
 	fromRev       string
 	patchFilePath string
 	wholeFiles    bool
 	patch         string
+
+	// The checker is prepared once, because the issues can be processed in several steps,
+	// and it's shared by the forks of the processor.
+	checker *diffChecker
+
+	suppressions suppressionCounter
+}
+
+type diffChecker struct {
+	checker *revgrep.Checker
+	err     error
 }
 
-func NewDiff(cfg *config.Issues) *Diff {
-	return &Diff{
-		onlyNew:       cfg.Diff,
-		fromRev:       cfg.DiffFromRevision,
-		patchFilePath: cfg.DiffPatchFilePath,
-		wholeFiles:    cfg.WholeFiles,
+func NewDiff(cfg *config.Config) *Diff {
+	p := &Diff{
+		onlyNew:       cfg.Issues.Diff,
+		fromRev:       cfg.Issues.DiffFromRevision,
+		patchFilePath: cfg.Issues.DiffPatchFilePath,
+		wholeFiles:    cfg.Issues.WholeFiles,
 		patch:         os.Getenv(envGolangciDiffProcessorPatch),
+		checker:       &diffChecker{},
+		suppressions:  suppressionCounter{},
 	}
+
+	// When only some outputs show the new issues, the new issues are marked instead of hiding the others.
+	p.markOnly = !p.enabled() && cfg.Output.Formats.HasOnlyNew()
+
+	return p
 }
 
-func (Diff) Name() string {
+func (*Diff) Name() string {
 	return "diff"
 }
 
-func (p Diff) Process(issues []result.Issue) ([]result.Issue, error) {
-	if !p.onlyNew && p.fromRev == "" && p.patchFilePath == "" && p.patch == "" { // no need to work
+func (p *Diff) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled() && !p.markOnly { // no need to work
 		return issues, nil
 	}
 
+	c, err := p.getChecker()
+	if err != nil {
+		return nil, err
+	}
+
+	return transformIssues(issues, func(issue *result.Issue) *result.Issue {
+		newIssue := *issue
+		newIssue.IsNew = true
+
+		if issue.FromLinter == typeCheckName {
+			// Never hide typechecking errors.
+			return &newIssue
+		}
+
+		hunkPos, isNew := c.IsNewIssue(issue)
+		if !isNew {
+			if p.markOnly {
+				return issue
+			}
+
+			p.suppressions.add(result.SuppressionNewFromRev, p.reference(), "", issue)
+			return nil
+		}
+
+		newIssue.HunkPos = hunkPos
+		return &newIssue
+	}), nil
+}
+
+func (*Diff) Finish() {}
+
+// Fork returns a copy of the processor sharing the checker (the changes are computed once),
+// the issues hidden by the copy are not recorded by the processor.
+func (p *Diff) Fork() *Diff {
+	fork := *p
+	fork.suppressions = suppressionCounter{}
+
+	return &fork
+}
+
+// Suppressions returns the issues hidden because they are not new.
+func (p *Diff) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
+func (p *Diff) getChecker() (*revgrep.Checker, error) {
+	if p.checker.checker != nil || p.checker.err != nil {
+		return p.checker.checker, p.checker.err
+	}
+
 	var patchReader io.Reader
 	if p.patchFilePath != "" {
 		patch, err := os.ReadFile(p.patchFilePath)
 		if err != nil {
-			return nil, fmt.Errorf("can't read from patch file %s: %w", p.patchFilePath, err)
+			p.checker.err = fmt.Errorf("can't read from patch file %s: %w", p.patchFilePath, err)
+			return nil, p.checker.err
 		}
 		patchReader = bytes.NewReader(patch)
 	} else if p.patch != "" {
 		patchReader = strings.NewReader(p.patch)
 	}
 
-	c := revgrep.Checker{
+	c := &revgrep.Checker{
 		Patch:        patchReader,
 		RevisionFrom: p.fromRev,
 		WholeFiles:   p.wholeFiles,
 	}
 	if err := c.Prepare(); err != nil {
-		return nil, fmt.Errorf("can't prepare diff by revgrep: %w", err)
+		p.checker.err = fmt.Errorf("can't prepare diff by revgrep: %w", err)
+		return nil, p.checker.err
 	}
 
-	return transformIssues(issues, func(issue *result.Issue) *result.Issue {
-		if issue.FromLinter == typeCheckName {
-			// Never hide typechecking errors.
-			return issue
-		}
+	p.checker.checker = c
 
-		hunkPos, isNew := c.IsNewIssue(issue)
-		if !isNew {
-			return nil
-		}
+	return c, nil
+}
 
-		newIssue := *issue
-		newIssue.HunkPos = hunkPos
-		return &newIssue
-	}), nil
+// enabled returns true if only the new issues are reported.
+func (p *Diff) enabled() bool {
+	return p.onlyNew || p.fromRev != "" || p.patchFilePath != "" || p.patch != ""
 }
 
-func (Diff) Finish() {}
+// reference returns the reference used to find the new issues: a revision, a patch, or the uncommitted changes.
+func (p *Diff) reference() string {
+	switch {
+	case p.fromRev != "":
+		return p.fromRev
+	case p.patchFilePath != "":
+		return p.patchFilePath
+	case p.patch != "":
+		return envGolangciDiffProcessorPatch
+	default:
+		return "HEAD"
+	}
+}
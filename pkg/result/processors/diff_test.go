@@ -0,0 +1,84 @@
+package processors
+
+import (
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/golangci/revgrep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const diffTestPatch = `diff --git a/a.go b/a.go
+index 0000000..1111111 100644
+--- a/a.go
++++ b/a.go
+@@ -1,3 +1,4 @@
+ package a
+ 
++var b = 1
+ var a = 1
+`
+
+func TestNewDiff_markOnly(t *testing.T) {
+	cfg := &config.Config{
+		Output: config.Output{Formats: config.OutputFormats{{Format: "json"}, {Format: "json", OnlyNew: true}}},
+	}
+
+	assert.True(t, NewDiff(cfg).markOnly)
+
+	// All the outputs show only the new issues.
+	cfg.Issues.DiffFromRevision = "HEAD~1"
+
+	assert.False(t, NewDiff(cfg).markOnly)
+}
+
+func TestDiff_Process_markOnly(t *testing.T) {
+	checker := &revgrep.Checker{Patch: strings.NewReader(diffTestPatch)}
+	require.NoError(t, checker.Prepare())
+
+	// Only some outputs show the new issues: the issues are marked, not hidden.
+	p := &Diff{markOnly: true, checker: &diffChecker{checker: checker}, suppressions: suppressionCounter{}}
+
+	issues := []result.Issue{
+		{FromLinter: "linter", Text: "old", Pos: token.Position{Filename: "a.go", Line: 4}},
+		{FromLinter: "linter", Text: "new", Pos: token.Position{Filename: "a.go", Line: 3}},
+		{FromLinter: "linter", Text: "unchanged file", Pos: token.Position{Filename: "b.go", Line: 3}},
+	}
+
+	processedIssues := process(t, p, issues...)
+
+	require.Len(t, processedIssues, 3)
+
+	assert.False(t, processedIssues[0].IsNew)
+	assert.True(t, processedIssues[1].IsNew)
+	assert.False(t, processedIssues[2].IsNew)
+
+	for _, issue := range processedIssues {
+		assert.Nil(t, issue.HiddenBy)
+	}
+
+	assert.Empty(t, p.Suppressions())
+}
+
+func TestDiff_Fork(t *testing.T) {
+	checker := &revgrep.Checker{Patch: strings.NewReader(diffTestPatch)}
+	require.NoError(t, checker.Prepare())
+
+	p := &Diff{fromRev: "HEAD~1", checker: &diffChecker{checker: checker}, suppressions: suppressionCounter{}}
+
+	fork := p.Fork()
+
+	// The changes are shared, the hidden issues are not.
+	assert.Same(t, p.checker, fork.checker)
+
+	processedIssues := process(t, fork, result.Issue{FromLinter: "linter", Text: "old", Pos: token.Position{Filename: "a.go", Line: 4}})
+	assert.Empty(t, processedIssues)
+
+	assert.Equal(t, []result.Suppression{{Kind: result.SuppressionNewFromRev, Name: "HEAD~1", Linter: "linter", Count: 1}}, fork.Suppressions())
+	assert.Empty(t, p.Suppressions())
+}
@@ -1,9 +1,7 @@
 package processors
 
 import (
-	"fmt"
 	"regexp"
-	"strings"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -14,15 +12,22 @@ var _ Processor = (*Exclude)(nil)
 type Exclude struct {
 	name string
 
+	patterns []excludePattern
+
+	suppressions suppressionCounter
+}
+
+type excludePattern struct {
+	// Only used for reporting.
+	raw string
+
 	pattern *regexp.Regexp
 }
 
 func NewExclude(cfg *config.Issues) *Exclude {
-	p := &Exclude{name: "exclude"}
-
-	var pattern string
-	if len(cfg.ExcludePatterns) != 0 {
-		pattern = fmt.Sprintf("(%s)", strings.Join(cfg.ExcludePatterns, "|"))
+	p := &Exclude{
+		name:         "exclude",
+		suppressions: suppressionCounter{},
 	}
 
 	prefix := caseInsensitivePrefix
@@ -31,25 +36,40 @@ func NewExclude(cfg *config.Issues) *Exclude {
 		prefix = ""
 	}
 
-	if pattern != "" {
-		p.pattern = regexp.MustCompile(prefix + pattern)
+	for _, pattern := range cfg.ExcludePatterns {
+		p.patterns = append(p.patterns, excludePattern{
+			raw:     pattern,
+			pattern: regexp.MustCompile(prefix + pattern),
+		})
 	}
 
 	return p
 }
 
-func (p Exclude) Name() string {
+func (p *Exclude) Name() string {
 	return p.name
 }
 
-func (p Exclude) Process(issues []result.Issue) ([]result.Issue, error) {
-	if p.pattern == nil {
+func (p *Exclude) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.patterns) == 0 {
 		return issues, nil
 	}
 
 	return filterIssues(issues, func(issue *result.Issue) bool {
-		return !p.pattern.MatchString(issue.Text)
+		for _, pattern := range p.patterns {
+			if pattern.pattern.MatchString(issue.Text) {
+				p.suppressions.add(result.SuppressionExclude, pattern.raw, "", issue)
+				return false
+			}
+		}
+
+		return true
 	}), nil
 }
 
-func (Exclude) Finish() {}
+func (*Exclude) Finish() {}
+
+// Suppressions returns the issues excluded by the patterns.
+func (p *Exclude) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
@@ -1,10 +1,14 @@
 package processors
 
 import (
+	"fmt"
 	"regexp"
+	"slices"
+	"time"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -13,22 +17,40 @@ var _ Processor = (*ExcludeRules)(nil)
 
 type excludeRule struct {
 	baseRule
+
+	// Only used for logging and reporting.
+	name    string
+	kind    string
+	reason  string
+	matches int
+
+	// The default exclude patterns are not reported when unused.
+	reportUnused bool
 }
 
 type ExcludeRules struct {
 	name string
 
-	log   logutils.Log
-	files *fsutils.Files
+	log     logutils.Log
+	files   *fsutils.Files
+	symbols *SymbolIndex
 
 	rules []excludeRule
+
+	// The default exclude patterns explicitly included (i.e. disabled):
+	// they don't exclude issues, they are only used to report the useless inclusions.
+	included []excludeRule
+
+	suppressions suppressionCounter
 }
 
-func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues) *ExcludeRules {
+func NewExcludeRules(log logutils.Log, files *fsutils.Files, symbols *SymbolIndex, cfg *config.Issues) *ExcludeRules {
 	p := &ExcludeRules{
-		name:  "exclude-rules",
-		files: files,
-		log:   log,
+		name:         "exclude-rules",
+		files:        files,
+		symbols:      symbols,
+		log:          log,
+		suppressions: suppressionCounter{},
 	}
 
 	prefix := caseInsensitivePrefix
@@ -37,69 +59,153 @@ func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues)
 		p.name = "exclude-rules-case-sensitive"
 	}
 
-	excludeRules := cfg.ExcludeRules
+	now := time.Now()
+
+	for i := range cfg.ExcludeRules {
+		rule := &cfg.ExcludeRules[i]
+
+		name := fmt.Sprintf("issues.exclude-rules[%d]", i)
+
+		if isRuleExpired(log, name, &rule.BaseRule, now) {
+			continue
+		}
+
+		parsedRule := createRule(rule, prefix, name)
+		parsedRule.kind = result.SuppressionExcludeRule
+		parsedRule.reportUnused = true
+
+		p.rules = append(p.rules, parsedRule)
+	}
 
 	if cfg.UseDefaultExcludes {
 		for _, r := range config.GetExcludePatterns(cfg.IncludeDefaultExcludes) {
-			excludeRules = append(excludeRules, config.ExcludeRule{
-				BaseRule: config.BaseRule{
-					Text:    r.Pattern,
-					Linters: []string{r.Linter},
-				},
-			})
+			parsedRule := createRule(newDefaultExcludeRule(r), prefix, r.ID)
+			parsedRule.kind = result.SuppressionDefaultExclusion
+
+			p.rules = append(p.rules, parsedRule)
 		}
-	}
 
-	p.rules = createRules(excludeRules, prefix)
+		for _, r := range config.DefaultExcludePatterns {
+			if !slices.Contains(cfg.IncludeDefaultExcludes, r.ID) {
+				continue
+			}
+
+			parsedRule := createRule(newDefaultExcludeRule(r), prefix, fmt.Sprintf("%s (issues.include)", r.ID))
+			parsedRule.reportUnused = true
+
+			p.included = append(p.included, parsedRule)
+		}
+	}
 
 	return p
 }
 
-func (p ExcludeRules) Name() string { return p.name }
+func (p *ExcludeRules) Name() string { return p.name }
 
-func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
-	if len(p.rules) == 0 {
+func (p *ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.rules) == 0 && len(p.included) == 0 {
 		return issues, nil
 	}
 
 	return filterIssues(issues, func(issue *result.Issue) bool {
-		for _, rule := range p.rules {
-			if rule.match(issue, p.files, p.log) {
+		for i := range p.rules {
+			rule := &p.rules[i]
+
+			if rule.match(issue, p.files, p.symbols, p.log) {
+				rule.matches++
+				p.suppressions.add(rule.kind, rule.name, rule.reason, issue)
 				return false
 			}
 		}
 
+		for i := range p.included {
+			rule := &p.included[i]
+
+			if rule.match(issue, p.files, p.symbols, p.log) {
+				rule.matches++
+			}
+		}
+
 		return true
 	}), nil
 }
 
-func (ExcludeRules) Finish() {}
+func (p *ExcludeRules) Finish() {
+	for i := range p.rules {
+		rule := &p.rules[i]
+
+		if rule.matches == 0 || rule.reason == "" {
+			continue
+		}
+
+		p.log.Infof("%d issue(s) excluded by %s: %s", rule.matches, rule.name, rule.reason)
+	}
+}
+
+// Suppressions returns the issues excluded by the rules.
+func (p *ExcludeRules) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
 
-func createRules(rules []config.ExcludeRule, prefix string) []excludeRule {
-	parsedRules := make([]excludeRule, 0, len(rules))
+// UnusedRules returns the names of the rules that didn't match any issue.
+// The rules about disabled linters are ignored.
+func (p *ExcludeRules) UnusedRules(enabledLinters map[string]*linter.Config) []string {
+	var names []string
 
-	for _, rule := range rules {
-		parsedRule := excludeRule{}
-		parsedRule.linters = rule.Linters
+	rules := slices.Concat(p.rules, p.included)
 
-		if rule.Text != "" {
-			parsedRule.text = regexp.MustCompile(prefix + rule.Text)
-		}
+	for i := range rules {
+		rule := &rules[i]
 
-		if rule.Source != "" {
-			parsedRule.source = regexp.MustCompile(prefix + rule.Source)
+		if rule.reportUnused && rule.matches == 0 && rule.lintersEnabled(enabledLinters) {
+			names = append(names, rule.name)
 		}
+	}
 
-		if rule.Path != "" {
-			parsedRule.path = regexp.MustCompile(fsutils.NormalizePathInRegex(rule.Path))
-		}
+	return names
+}
 
-		if rule.PathExcept != "" {
-			parsedRule.pathExcept = regexp.MustCompile(fsutils.NormalizePathInRegex(rule.PathExcept))
-		}
+func newDefaultExcludeRule(pattern config.ExcludePattern) *config.ExcludeRule {
+	return &config.ExcludeRule{
+		BaseRule: config.BaseRule{
+			Text:    pattern.Pattern,
+			Linters: []string{pattern.Linter},
+			Reason:  pattern.Why,
+		},
+	}
+}
+
+func createRule(rule *config.ExcludeRule, prefix, name string) excludeRule {
+	parsedRule := excludeRule{
+		name:   name,
+		reason: rule.Reason,
+	}
+
+	parsedRule.linters = rule.Linters
+
+	if rule.Text != "" {
+		parsedRule.text = regexp.MustCompile(prefix + rule.Text)
+	}
+
+	if rule.Source != "" {
+		parsedRule.source = regexp.MustCompile(prefix + rule.Source)
+	}
+
+	if rule.Path != "" {
+		parsedRule.path = regexp.MustCompile(fsutils.NormalizePathInRegex(rule.Path))
+	}
+
+	if rule.PathExcept != "" {
+		parsedRule.pathExcept = regexp.MustCompile(fsutils.NormalizePathInRegex(rule.PathExcept))
+	}
+
+	if rule.Symbol != "" {
+		parsedRule.symbol = regexp.MustCompile(rule.Symbol)
+	}
 
-		parsedRules = append(parsedRules, parsedRule)
+	if rule.Package != "" {
+		parsedRule.pkg = regexp.MustCompile(rule.Package)
 	}
 
-	return parsedRules
+	return parsedRule
 }
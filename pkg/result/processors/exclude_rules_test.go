@@ -1,17 +1,82 @@
 package processors
 
 import (
+	"go/token"
 	"path"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/packages"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
+func TestExcludeRules_symbolAndPackage(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	files := fsutils.NewFiles(lineCache, "")
+
+	opts := &config.Issues{ExcludeRules: []config.ExcludeRule{
+		{
+			BaseRule: config.BaseRule{
+				Symbol:  "^TestMain$",
+				Linters: []string{"funlen"},
+			},
+		},
+		{
+			BaseRule: config.BaseRule{
+				Symbol: `^Foo\.`,
+				Text:   "^method$",
+			},
+		},
+		{
+			BaseRule: config.BaseRule{
+				Package: "/metrics$",
+				Linters: []string{"gochecknoglobals"},
+			},
+		},
+	}}
+
+	p := NewExcludeRules(getMockLog(), files, NewSymbolIndex(nil), opts)
+
+	fileName := filepath.Join("testdata", "exclude_rules_symbols.go")
+
+	newIssue := func(line int, linter, text, pkgPath string) result.Issue {
+		issue := result.Issue{
+			Pos:        token.Position{Filename: fileName, Line: line},
+			FromLinter: linter,
+			Text:       text,
+		}
+
+		if pkgPath != "" {
+			issue.Pkg = &packages.Package{PkgPath: pkgPath}
+		}
+
+		return issue
+	}
+
+	issues := []result.Issue{
+		newIssue(9, "funlen", "", ""),
+		newIssue(17, "funlen", "", ""),
+		newIssue(17, "revive", "method", ""),
+		newIssue(13, "revive", "method", ""),
+		newIssue(5, "gochecknoglobals", "", "example.com/internal/metrics"),
+		newIssue(5, "gochecknoglobals", "", "example.com/internal/api"),
+		// Without package information, the directory of the file is not matched.
+		newIssue(5, "gochecknoglobals", "", ""),
+	}
+
+	processedIssues := process(t, p, issues...)
+
+	expected := []result.Issue{issues[1], issues[3], issues[5], issues[6]}
+
+	assert.Equal(t, expected, processedIssues)
+}
+
 func TestExcludeRules_multiple(t *testing.T) {
 	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
 	files := fsutils.NewFiles(lineCache, "")
@@ -49,7 +114,7 @@ func TestExcludeRules_multiple(t *testing.T) {
 		},
 	}}
 
-	p := NewExcludeRules(nil, files, opts)
+	p := NewExcludeRules(nil, files, nil, opts)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "exclude", Linter: "linter"},
@@ -105,7 +170,7 @@ func TestExcludeRules_pathPrefix(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, files, opts)
+	p := NewExcludeRules(nil, files, nil, opts)
 
 	cases := []issueTestCase{
 		{Path: "e.go"},
@@ -148,7 +213,7 @@ func TestExcludeRules_text(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, nil, opts)
+	p := NewExcludeRules(nil, nil, nil, opts)
 
 	texts := []string{"excLude", "1", "", "exclud", "notexclude"}
 	var issues []result.Issue
@@ -170,8 +235,147 @@ func TestExcludeRules_text(t *testing.T) {
 	assert.Equal(t, texts[1:], processedTexts)
 }
 
+func TestExcludeRules_expired(t *testing.T) {
+	log := logutils.NewMockLog().
+		OnWarnf("The rule %s expired on %s, it's no longer applied (reason: %s).",
+			"issues.exclude-rules[0]", "2000-01-01", "legacy code").
+		OnInfof("%d issue(s) excluded by %s: %s", 1, "issues.exclude-rules[1]", "false positive")
+
+	opts := &config.Issues{ExcludeRules: []config.ExcludeRule{
+		{
+			BaseRule: config.BaseRule{
+				Text:    "^expired$",
+				Linters: []string{"linter"},
+				Expires: "2000-01-01",
+				Reason:  "legacy code",
+			},
+		},
+		{
+			BaseRule: config.BaseRule{
+				Text:    "^active$",
+				Linters: []string{"linter"},
+				Expires: "9999-12-31",
+				Reason:  "false positive",
+			},
+		},
+	}}
+
+	p := NewExcludeRules(log, nil, nil, opts)
+
+	issues := []result.Issue{
+		{Text: "expired", FromLinter: "linter"},
+		{Text: "active", FromLinter: "linter"},
+	}
+
+	processedIssues := process(t, p, issues...)
+	p.Finish()
+
+	assert.Equal(t, issues[:1], processedIssues)
+
+	log.AssertExpectations(t)
+}
+
+func TestExcludeRules_UnusedRules(t *testing.T) {
+	opts := &config.Issues{
+		ExcludeRules: []config.ExcludeRule{
+			{
+				BaseRule: config.BaseRule{
+					Text:    "^used$",
+					Linters: []string{"linter"},
+				},
+			},
+			{
+				BaseRule: config.BaseRule{
+					Text:    "^unused$",
+					Linters: []string{"linter"},
+				},
+			},
+			{
+				BaseRule: config.BaseRule{
+					Text:    "^disabled$",
+					Linters: []string{"linter", "disabled"},
+				},
+			},
+		},
+		UseDefaultExcludes:     true,
+		IncludeDefaultExcludes: []string{"EXC0001", "EXC0002", "EXC0006"},
+	}
+
+	p := NewExcludeRules(nil, nil, nil, opts)
+
+	issues := []result.Issue{
+		{Text: "used", FromLinter: "linter"},
+		{Text: "Error return value of `f.Close` is not checked", FromLinter: "errcheck"},
+		{Text: "don't use underscores in Go names", FromLinter: "golint"},
+	}
+
+	processedIssues := process(t, p, issues...)
+
+	// The issues matching the included default patterns are not excluded.
+	assert.Equal(t, issues[1:], processedIssues)
+
+	enabledLinters := map[string]*linter.Config{
+		"linter":   {},
+		"errcheck": {},
+		"golint":   {},
+	}
+
+	// The rules about disabled linters (EXC0006 is about gosec) are not reported.
+	assert.Equal(t, []string{"issues.exclude-rules[1]", "EXC0002 (issues.include)"}, p.UnusedRules(enabledLinters))
+}
+
+func TestExcludeRules_Suppressions(t *testing.T) {
+	opts := &config.Issues{
+		ExcludeRules: []config.ExcludeRule{
+			{
+				BaseRule: config.BaseRule{
+					Text:    "^excluded$",
+					Linters: []string{"linter"},
+					Reason:  "JIRA-123",
+				},
+			},
+		},
+		UseDefaultExcludes: true,
+	}
+
+	p := NewExcludeRules(nil, nil, nil, opts)
+
+	pkg := &packages.Package{PkgPath: "example.com/a"}
+
+	// The package of the last issue is unknown (ex: issue restored from the cache).
+	issues := []result.Issue{
+		{Text: "excluded", FromLinter: "linter", Pos: token.Position{Filename: "a/b.go"}, Pkg: pkg},
+		{Text: "excluded", FromLinter: "linter", Pos: token.Position{Filename: "a/c.go"}, Pkg: pkg},
+		{Text: "Error return value of `f.Close` is not checked", FromLinter: "errcheck", Pos: token.Position{Filename: "d/e.go"}},
+		{Text: "reported", FromLinter: "linter", Pos: token.Position{Filename: "a/b.go"}},
+	}
+
+	processedIssues := process(t, p, issues...)
+	assert.Equal(t, issues[3:], processedIssues)
+
+	expected := []result.Suppression{
+		{
+			Kind:   result.SuppressionDefaultExclusion,
+			Name:   "EXC0001",
+			Reason: config.DefaultExcludePatterns[0].Why,
+			Linter: "errcheck",
+			Count:  1,
+		},
+		{
+			Kind:    result.SuppressionExcludeRule,
+			Name:    "issues.exclude-rules[0]",
+			Reason:  "JIRA-123",
+			Linter:  "linter",
+			Package: "example.com/a",
+			Count:   2,
+		},
+	}
+
+	assert.Equal(t, expected, p.Suppressions())
+}
+
 func TestExcludeRules_empty(t *testing.T) {
-	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{}), newIssueFromTextTestCase("test"))
+	processAssertSame(t, NewExcludeRules(nil, nil, nil, &config.Issues{}), newIssueFromTextTestCase("test"))
 }
 
 func TestExcludeRules_caseSensitive_multiple(t *testing.T) {
@@ -208,7 +412,7 @@ func TestExcludeRules_caseSensitive_multiple(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, files, opts)
+	p := NewExcludeRules(nil, files, nil, opts)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "exclude", Linter: "linter"},
@@ -264,7 +468,7 @@ func TestExcludeRules_caseSensitive_text(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, nil, opts)
+	p := NewExcludeRules(nil, nil, nil, opts)
 
 	texts := []string{"exclude", "excLude", "1", "", "exclud", "notexclude"}
 
@@ -288,5 +492,5 @@ func TestExcludeRules_caseSensitive_text(t *testing.T) {
 }
 
 func TestExcludeRules_caseSensitive_empty(t *testing.T) {
-	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{ExcludeCaseSensitive: true}), newIssueFromTextTestCase("test"))
+	processAssertSame(t, NewExcludeRules(nil, nil, nil, &config.Issues{ExcludeCaseSensitive: true}), newIssueFromTextTestCase("test"))
 }
@@ -3,8 +3,13 @@ package processors
 import (
 	"bytes"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -39,6 +44,10 @@ func (Fixer) Name() string {
 }
 
 func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.cfg.Issues.AddNolint {
+		return p.addNolintDirectives(issues), nil
+	}
+
 	if !p.cfg.Issues.NeedFix {
 		return issues, nil
 	}
@@ -255,6 +264,231 @@ func (p Fixer) writeFixedFile(origFileLines [][]byte, issues []result.Issue, tmp
 	return nil
 }
 
+// addNolintDirectives adds nolint directives for the issues (instead of fixing them).
+// It returns the issues that can't be handled.
+func (p Fixer) addNolintDirectives(issues []result.Issue) []result.Issue {
+	var outIssues []result.Issue
+
+	issuesPerFile := map[string][]result.Issue{}
+	for i := range issues {
+		issue := &issues[i]
+
+		// the compilation errors can't be ignored.
+		if issue.FromLinter == typeCheckName {
+			outIssues = append(outIssues, *issue)
+			continue
+		}
+
+		issuesPerFile[issue.FilePath()] = append(issuesPerFile[issue.FilePath()], *issue)
+	}
+
+	for file, fileIssues := range issuesPerFile {
+		err := p.sw.TrackStageErr("nolint", func() error {
+			notHandled, err := p.addNolintDirectivesInFile(file, fileIssues)
+			outIssues = append(outIssues, notHandled...)
+			return err
+		})
+		if err != nil {
+			p.log.Errorf("Failed to add nolint directives in file %s: %s", file, err)
+
+			outIssues = append(outIssues, fileIssues...)
+		}
+	}
+
+	p.printStat()
+
+	return outIssues
+}
+
+// addNolintDirectivesInFile adds nolint directives to the lines of the issues,
+// by replacing each line through the same path as the fixes.
+func (p Fixer) addNolintDirectivesInFile(filePath string, issues []result.Issue) ([]result.Issue, error) {
+	src, err := p.fileCache.GetFileBytes(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filePath, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filePath, err)
+	}
+
+	lines := newNolintLines(fset, f)
+	origFileLines := bytes.Split(src, []byte("\n"))
+
+	lintersPerLine := map[int][]string{}
+	for i := range issues {
+		issue := &issues[i]
+
+		if !slices.Contains(lintersPerLine[issue.Line()], issue.FromLinter) {
+			lintersPerLine[issue.Line()] = append(lintersPerLine[issue.Line()], issue.FromLinter)
+		}
+	}
+
+	var notHandled, nolintIssues []result.Issue
+
+	for i := range issues {
+		issue := &issues[i]
+
+		linters, ok := lintersPerLine[issue.Line()]
+		if !ok {
+			continue // the line is already handled.
+		}
+
+		delete(lintersPerLine, issue.Line())
+
+		if issue.Line() < 1 || issue.Line() > len(origFileLines) || lines.unsafe[issue.Line()] || lines.commentOnly[issue.Line()] {
+			p.log.Infof("Can't add a nolint directive on line %d of %s", issue.Line(), filePath)
+			notHandled = append(notHandled, *issue)
+			continue
+		}
+
+		commentCol, ok := lines.comments[issue.Line()]
+		if !ok {
+			commentCol = -1
+		}
+
+		newLine := addNolintDirective(string(origFileLines[issue.Line()-1]), commentCol, linters, p.cfg.Issues.AddNolintReason)
+
+		nolintIssues = append(nolintIssues, result.Issue{
+			FromLinter:  issue.FromLinter,
+			Pos:         token.Position{Filename: issue.Pos.Filename, Line: issue.Line()},
+			Replacement: &result.Replacement{NewLines: []string{newLine}},
+		})
+	}
+
+	if len(nolintIssues) == 0 {
+		return notHandled, nil
+	}
+
+	return notHandled, p.fixIssuesInFile(filePath, nolintIssues)
+}
+
 func (p Fixer) printStat() {
 	p.sw.PrintStages()
 }
+
+// nolintLines contains the information needed to add nolint directives at the end of the lines of a file.
+type nolintLines struct {
+	// the column (0-based) of the line comment of each line.
+	comments map[int]int
+	// the lines inside multi-line strings or comments: a comment can't be added at the end of these lines.
+	unsafe map[int]bool
+	// the lines without code: a nolint directive can't be inline.
+	commentOnly map[int]bool
+}
+
+func newNolintLines(fset *token.FileSet, f *ast.File) nolintLines {
+	lines := nolintLines{
+		comments:    map[int]int{},
+		unsafe:      map[int]bool{},
+		commentOnly: map[int]bool{},
+	}
+
+	markUnsafe := func(node ast.Node) {
+		for line := fset.Position(node.Pos()).Line; line < fset.Position(node.End()).Line; line++ {
+			lines.unsafe[line] = true
+		}
+	}
+
+	for _, g := range f.Comments {
+		for _, c := range g.List {
+			if !strings.HasPrefix(c.Text, "//") {
+				markUnsafe(c)
+				continue
+			}
+
+			pos := fset.Position(c.Pos())
+			if _, ok := lines.comments[pos.Line]; !ok {
+				lines.comments[pos.Line] = pos.Column - 1
+				lines.commentOnly[pos.Line] = true
+			}
+		}
+	}
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case nil, *ast.File, *ast.CommentGroup, *ast.Comment:
+			return true
+
+		case *ast.BasicLit:
+			if n.Kind == token.STRING {
+				markUnsafe(n)
+			}
+		}
+
+		// a line with code is not a comment-only line.
+		delete(lines.commentOnly, fset.Position(node.Pos()).Line)
+		delete(lines.commentOnly, fset.Position(node.End()).Line)
+
+		return true
+	})
+
+	return lines
+}
+
+// nolintDirectivePattern matches a nolint directive with the list of linters (ex: `//nolint:gosec(G304),lll`).
+var nolintDirectivePattern = regexp.MustCompile(`^//\s*nolint(?::(\s*[\w-]+(?:\([\w.-]+\))?(?:\s*,\s*[\w-]+(?:\([\w.-]+\))?)*))?`)
+
+// addNolintDirective adds the linters to the nolint directive of the line,
+// or adds a nolint directive before the line comment (commentCol), or at the end of the line (commentCol < 0).
+func addNolintDirective(line string, commentCol int, linters []string, reason string) string {
+	line, cr := strings.CutSuffix(line, "\r") // preserve the Windows line endings.
+
+	code, comment := strings.TrimRight(line, " \t"), ""
+	if commentCol >= 0 && commentCol <= len(line) {
+		code, comment = strings.TrimRight(line[:commentCol], " \t"), line[commentCol:]
+	}
+
+	newLine := code + " " + mergeNolintDirective(comment, linters, reason)
+
+	if cr {
+		newLine += "\r"
+	}
+
+	return newLine
+}
+
+// mergeNolintDirective adds the linters to the nolint directive at the beginning of the comment,
+// or adds a nolint directive before the comment.
+func mergeNolintDirective(comment string, linters []string, reason string) string {
+	sort.Strings(linters)
+
+	explanation := ""
+	if reason != "" {
+		explanation = " // " + reason
+	}
+
+	loc := nolintDirectivePattern.FindStringSubmatchIndex(comment)
+
+	switch {
+	case loc == nil:
+		return strings.TrimSpace("//nolint:" + strings.Join(linters, ",") + explanation + " " + comment)
+
+	case loc[2] < 0:
+		return comment // `//nolint` already ignores all the linters.
+	}
+
+	existing := strings.Split(comment[loc[2]:loc[3]], ",")
+	for i := range existing {
+		existing[i] = strings.TrimSpace(existing[i])
+	}
+
+	if slices.Contains(existing, "all") {
+		return comment
+	}
+
+	for _, linter := range linters {
+		if !slices.Contains(existing, linter) {
+			existing = append(existing, linter)
+		}
+	}
+
+	rest := comment[loc[3]:]
+	if strings.TrimSpace(rest) == "" {
+		rest = explanation
+	}
+
+	return comment[:loc[2]] + strings.Join(existing, ",") + rest
+}
@@ -0,0 +1,113 @@
+package processors
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_addNolintDirective(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		line       string
+		commentCol int
+		linters    []string
+		reason     string
+		expected   string
+	}{
+		{
+			desc:       "without comment",
+			line:       "\tf() ",
+			commentCol: -1,
+			linters:    []string{"lll", "errcheck"},
+			expected:   "\tf() //nolint:errcheck,lll",
+		},
+		{
+			desc:       "with reason",
+			line:       "\tf()",
+			commentCol: -1,
+			linters:    []string{"errcheck"},
+			reason:     "legacy code",
+			expected:   "\tf() //nolint:errcheck // legacy code",
+		},
+		{
+			desc:       "before a comment",
+			line:       "\tf() // call f",
+			commentCol: 5,
+			linters:    []string{"errcheck"},
+			reason:     "legacy code",
+			expected:   "\tf() //nolint:errcheck // legacy code // call f",
+		},
+		{
+			desc:       "existing directive",
+			line:       "\tf() //nolint:lll,gosec(G304) // too long",
+			commentCol: 5,
+			linters:    []string{"errcheck", "lll"},
+			reason:     "legacy code",
+			expected:   "\tf() //nolint:lll,gosec(G304),errcheck // too long",
+		},
+		{
+			desc:       "existing directive without explanation",
+			line:       "\tf() //nolint:lll",
+			commentCol: 5,
+			linters:    []string{"errcheck"},
+			reason:     "legacy code",
+			expected:   "\tf() //nolint:lll,errcheck // legacy code",
+		},
+		{
+			desc:       "existing directive for all linters",
+			line:       "\tf() //nolint:all // legacy code",
+			commentCol: 5,
+			linters:    []string{"errcheck"},
+			expected:   "\tf() //nolint:all // legacy code",
+		},
+		{
+			desc:       "Windows line ending",
+			line:       "\tf()\r",
+			commentCol: -1,
+			linters:    []string{"errcheck"},
+			expected:   "\tf() //nolint:errcheck\r",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			line := addNolintDirective(test.line, test.commentCol, test.linters, test.reason)
+
+			assert.Equal(t, test.expected, line)
+		})
+	}
+}
+
+func Test_newNolintLines(t *testing.T) {
+	src := `package p
+
+// comment
+var a = 1 // comment
+
+var b = ` + "`" + `
+multi-line
+` + "`" + `
+
+/* multi-line
+comment */
+var c = []int{
+	1, // comment
+}
+`
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", src, parser.ParseComments)
+	require.NoError(t, err)
+
+	lines := newNolintLines(fset, f)
+
+	assert.Equal(t, map[int]int{3: 0, 4: 10, 13: 4}, lines.comments)
+	assert.Equal(t, map[int]bool{6: true, 7: true, 10: true}, lines.unsafe)
+	assert.Equal(t, map[int]bool{3: true}, lines.commentOnly)
+}
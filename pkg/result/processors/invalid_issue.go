@@ -42,7 +42,7 @@ func (p InvalidIssue) shouldPassIssue(issue *result.Issue) (bool, error) {
 		return false, nil
 	}
 
-	if filepath.Base(issue.FilePath()) == "go.mod" {
+	if filepath.Base(issue.FilePath()) == goModFileName {
 		return true, nil
 	}
 
@@ -1,6 +1,8 @@
 package processors
 
 import (
+	"fmt"
+
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -13,6 +15,8 @@ type MaxFromLinter struct {
 	limit         int
 	log           logutils.Log
 	cfg           *config.Config
+
+	suppressions suppressionCounter
 }
 
 func NewMaxFromLinter(limit int, log logutils.Log, cfg *config.Config) *MaxFromLinter {
@@ -21,6 +25,8 @@ func NewMaxFromLinter(limit int, log logutils.Log, cfg *config.Config) *MaxFromL
 		limit:         limit,
 		log:           log,
 		cfg:           cfg,
+
+		suppressions: suppressionCounter{},
 	}
 }
 
@@ -41,7 +47,12 @@ func (p *MaxFromLinter) Process(issues []result.Issue) ([]result.Issue, error) {
 
 		p.linterCounter[issue.FromLinter]++ // always inc for stat
 
-		return p.linterCounter[issue.FromLinter] <= p.limit
+		hidden := p.linterCounter[issue.FromLinter] > p.limit
+		if hidden {
+			p.suppressions.add(result.SuppressionMaxIssuesPerLinter, fmt.Sprintf("max-issues-per-linter: %d", p.limit), "", issue)
+		}
+
+		return !hidden
 	}), nil
 }
 
@@ -53,3 +64,8 @@ func (p *MaxFromLinter) Finish() {
 		}
 	})
 }
+
+// Suppressions returns the issues hidden because of the limit of issues per linter.
+func (p *MaxFromLinter) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
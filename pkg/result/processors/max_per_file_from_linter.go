@@ -10,6 +10,8 @@ var _ Processor = (*MaxPerFileFromLinter)(nil)
 type MaxPerFileFromLinter struct {
 	fileLinterCounter          fileLinterCounter
 	maxPerFileFromLinterConfig map[string]int
+
+	suppressions suppressionCounter
 }
 
 func NewMaxPerFileFromLinter(cfg *config.Config) *MaxPerFileFromLinter {
@@ -25,6 +27,7 @@ func NewMaxPerFileFromLinter(cfg *config.Config) *MaxPerFileFromLinter {
 	return &MaxPerFileFromLinter{
 		fileLinterCounter:          fileLinterCounter{},
 		maxPerFileFromLinterConfig: maxPerFileFromLinterConfig,
+		suppressions:               suppressionCounter{},
 	}
 }
 
@@ -40,6 +43,7 @@ func (p *MaxPerFileFromLinter) Process(issues []result.Issue) ([]result.Issue, e
 		}
 
 		if p.fileLinterCounter.GetCount(issue) >= limit {
+			p.suppressions.add(result.SuppressionMaxPerFileFromLinter, issue.FilePath(), "", issue)
 			return false
 		}
 
@@ -51,6 +55,11 @@ func (p *MaxPerFileFromLinter) Process(issues []result.Issue) ([]result.Issue, e
 
 func (*MaxPerFileFromLinter) Finish() {}
 
+// Suppressions returns the issues hidden because of the limit of issues per file and linter.
+func (p *MaxPerFileFromLinter) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
 type fileLinterCounter map[string]map[string]int
 
 func (f fileLinterCounter) GetCount(issue *result.Issue) int {
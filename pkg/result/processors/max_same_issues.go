@@ -15,6 +15,8 @@ type MaxSameIssues struct {
 	limit       int
 	log         logutils.Log
 	cfg         *config.Config
+
+	suppressions suppressionCounter
 }
 
 func NewMaxSameIssues(limit int, log logutils.Log, cfg *config.Config) *MaxSameIssues {
@@ -23,6 +25,8 @@ func NewMaxSameIssues(limit int, log logutils.Log, cfg *config.Config) *MaxSameI
 		limit:       limit,
 		log:         log,
 		cfg:         cfg,
+
+		suppressions: suppressionCounter{},
 	}
 }
 
@@ -42,7 +46,13 @@ func (p *MaxSameIssues) Process(issues []result.Issue) ([]result.Issue, error) {
 		}
 
 		p.textCounter[issue.Text]++ // always inc for stat
-		return p.textCounter[issue.Text] <= p.limit
+		if p.textCounter[issue.Text] <= p.limit {
+			return true
+		}
+
+		p.suppressions.add(result.SuppressionMaxSameIssues, issue.Text, "", issue)
+
+		return false
 	}), nil
 }
 
@@ -55,6 +65,11 @@ func (p *MaxSameIssues) Finish() {
 	})
 }
 
+// Suppressions returns the issues hidden because of the limit of issues with the same text.
+func (p *MaxSameIssues) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
 type kv struct {
 	Key   string
 	Value int
@@ -1,14 +1,20 @@
 package processors
 
 import (
+	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"golang.org/x/exp/maps"
+	"golang.org/x/mod/modfile"
 
 	"github.com/golangci/golangci-lint/pkg/golinters/nolintlint"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
@@ -23,10 +29,17 @@ var nolintDebugf = logutils.Debug(logutils.DebugKeyNolint)
 
 type ignoredRange struct {
 	linters                []string
+	rules                  map[string][]string // the rules of the linters restricted to some rules (ex: `gosec(G304)`)
 	matchedIssueFromLinter map[string]bool
+	matchedIssueFromRule   map[string]bool
 	result.Range
 	col           int
 	originalRange *ignoredRange // pre-expanded range (used to match nolintlint issues)
+	region        bool          // range between `//golangci:disable` and `//golangci:enable`
+
+	// Only used for reporting.
+	position    string // position of the directive
+	explanation string
 }
 
 func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
@@ -38,7 +51,7 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	nolintFoundForLinter := len(i.linters) == 0 && issue.FromLinter != nolintlint.LinterName
 
 	for _, linterName := range i.linters {
-		if linterName == issue.FromLinter {
+		if linterName == issue.FromLinter && i.matchRule(issue) {
 			nolintFoundForLinter = true
 			break
 		}
@@ -51,6 +64,14 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	// handle possible unused nolint directives
 	// nolintlint generates potential issues for every nolint directive, and they are filtered out here
 	if issue.FromLinter == nolintlint.LinterName && issue.ExpectNoLint {
+		// a region only handles the issues about its own directive, not the ones about the nolint directives inside it
+		if i.region && issue.Line() != i.From {
+			return false
+		}
+
+		if issue.ExpectedNoLintRule != "" {
+			return i.matchedIssueFromRule[ruleKey(issue.ExpectedNoLintLinter, issue.ExpectedNoLintRule)]
+		}
 		if issue.ExpectedNoLintLinter != "" {
 			return i.matchedIssueFromLinter[issue.ExpectedNoLintLinter]
 		}
@@ -60,6 +81,29 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	return false
 }
 
+func (i *ignoredRange) matchRule(issue *result.Issue) bool {
+	rules, ok := i.rules[issue.FromLinter]
+	if !ok {
+		return true
+	}
+
+	return slices.ContainsFunc(rules, func(rule string) bool {
+		return strings.EqualFold(rule, issue.RuleID)
+	})
+}
+
+func (i *ignoredRange) markAsMatched(issue *result.Issue) {
+	i.matchedIssueFromLinter[issue.FromLinter] = true
+
+	if issue.RuleID != "" {
+		i.matchedIssueFromRule[ruleKey(issue.FromLinter, issue.RuleID)] = true
+	}
+}
+
+func ruleKey(linterName, rule string) string {
+	return linterName + "(" + strings.ToLower(rule) + ")"
+}
+
 type fileData struct {
 	ignoredRanges []ignoredRange
 }
@@ -73,6 +117,10 @@ type Nolint struct {
 	unknownLintersSet map[string]bool
 
 	pattern *regexp.Regexp
+
+	now time.Time
+
+	suppressions suppressionCounter
 }
 
 func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config) *Nolint {
@@ -83,6 +131,8 @@ func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters ma
 		log:               log,
 		unknownLintersSet: map[string]bool{},
 		pattern:           regexp.MustCompile(`^nolint( |:|$)`),
+		now:               time.Now(),
+		suppressions:      suppressionCounter{},
 	}
 }
 
@@ -107,6 +157,11 @@ func (p *Nolint) Finish() {
 	p.log.Warnf("Found unknown linters in //nolint directives: %s", strings.Join(unknownLinters, ", "))
 }
 
+// Suppressions returns the issues excluded by the nolint directives.
+func (p *Nolint) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
 func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 	nolintDebugf("got issue: %v", *issue)
 
@@ -130,10 +185,15 @@ func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 
 		nolintDebugf("found ignored range for issue %v: %v", issue, ir)
 
-		ir.matchedIssueFromLinter[issue.FromLinter] = true
+		ir.markAsMatched(issue)
 
 		if ir.originalRange != nil {
-			ir.originalRange.matchedIssueFromLinter[issue.FromLinter] = true
+			ir.originalRange.markAsMatched(issue)
+		}
+
+		// The issues about the unused directives are only used to check the directives.
+		if !issue.ExpectNoLint {
+			p.suppressions.add(result.SuppressionNolint, ir.position, ir.explanation, issue)
 		}
 
 		return false, nil
@@ -151,6 +211,14 @@ func (p *Nolint) getOrCreateFileData(issue *result.Issue) *fileData {
 	fd = &fileData{}
 	p.fileCache[issue.FilePath()] = fd
 
+	if filepath.Base(issue.FilePath()) == goModFileName {
+		fd.ignoredRanges = p.extractGoModRanges(issue.FilePath())
+
+		nolintDebugf("file %s: built nolint ranges are %+v", issue.FilePath(), fd.ignoredRanges)
+
+		return fd
+	}
+
 	// TODO: migrate this parsing to go/analysis facts
 	// or cache them somehow per file.
 
@@ -163,6 +231,7 @@ func (p *Nolint) getOrCreateFileData(issue *result.Issue) *fileData {
 	}
 
 	fd.ignoredRanges = p.buildIgnoredRangesForFile(f, fset, issue.FilePath())
+	fd.ignoredRanges = append(fd.ignoredRanges, p.extractFileRegions(fset, f)...)
 
 	nolintDebugf("file %s: built nolint ranges are %+v", issue.FilePath(), fd.ignoredRanges)
 
@@ -206,54 +275,231 @@ func (p *Nolint) extractFileCommentsInlineRanges(fset *token.FileSet, comments .
 }
 
 func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *token.FileSet) *ignoredRange {
+	return p.parseNolintDirective(text, fset.Position(g.Pos()), fset.Position(g.End()).Line)
+}
+
+// parseNolintDirective builds the range of a nolint directive, from the position of the directive to the line `to`.
+// It returns nil if the text is not a nolint directive.
+func (p *Nolint) parseNolintDirective(text string, pos token.Position, to int) *ignoredRange {
 	text = strings.TrimLeft(text, "/ ")
 	if !p.pattern.MatchString(text) {
 		return nil
 	}
 
-	buildRange := func(linters []string) *ignoredRange {
-		pos := fset.Position(g.Pos())
+	if p.isExpired(text) {
+		nolintDebugf("%d: directive %q is expired", pos.Line, text)
+		return nil
+	}
+
+	explanation := directiveExplanation(text)
+
+	buildRange := func(linters []string, rules map[string][]string) *ignoredRange {
 		return &ignoredRange{
 			Range: result.Range{
 				From: pos.Line,
-				To:   fset.Position(g.End()).Line,
+				To:   to,
 			},
 			col:                    pos.Column,
 			linters:                linters,
+			rules:                  rules,
 			matchedIssueFromLinter: make(map[string]bool),
+			matchedIssueFromRule:   make(map[string]bool),
+			position:               directivePosition(pos),
+			explanation:            explanation,
 		}
 	}
 
 	if strings.HasPrefix(text, "nolint:all") || !strings.HasPrefix(text, "nolint:") {
-		return buildRange(nil) // ignore all linters
+		return buildRange(nil, nil) // ignore all linters
 	}
 
-	// ignore specific linters
-	var linters []string
+	// ignore specific linters, or specific rules of linters (ex: `gosec(G304)`)
 	text = strings.Split(text, "//")[0] // allow another comment after this comment
-	linterItems := strings.Split(strings.TrimPrefix(text, "nolint:"), ",")
-	for _, item := range linterItems {
-		linterName := strings.ToLower(strings.TrimSpace(item))
+	linters, rules, all := p.parseLinterItems(strings.TrimPrefix(text, "nolint:"), pos.Line)
+	if all {
+		return buildRange(nil, nil)
+	}
+
+	nolintDebugf("%d: linters are %s, rules are %v", pos.Line, linters, rules)
+	return buildRange(linters, rules)
+}
+
+// extractGoModRanges builds the ranges of the nolint directives of a go.mod file (ex: `replace a => b // nolint:gomoddirectives`).
+// A directive at the end of a line applies to this line,
+// a directive before a line (or a block) applies to the line (or the block).
+func (p *Nolint) extractGoModRanges(filePath string) []ignoredRange {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil
+	}
+
+	file, err := modfile.ParseLax(filePath, data, nil)
+	if err != nil {
+		// Don't report error because it's already reported by the linters based on go.mod.
+		return nil
+	}
+
+	var ranges []ignoredRange
+
+	addRanges := func(expr modfile.Expr) {
+		_, end := expr.Span()
+
+		comments := expr.Comment()
+
+		for _, c := range slices.Concat(comments.Before, comments.Suffix) {
+			pos := token.Position{Filename: filePath, Line: c.Start.Line, Column: c.Start.LineRune}
+
+			ir := p.parseNolintDirective(c.Token, pos, end.Line)
+			if ir != nil {
+				ranges = append(ranges, *ir)
+			}
+		}
+	}
+
+	for _, stmt := range file.Syntax.Stmt {
+		addRanges(stmt)
+
+		if block, ok := stmt.(*modfile.LineBlock); ok {
+			for _, line := range block.Line {
+				addRanges(line)
+			}
+		}
+	}
+
+	return ranges
+}
+
+// extractFileRegions builds the ranges between the `//golangci:disable` and `//golangci:enable` directives.
+// A `//golangci:enable` directive ends all the regions in progress,
+// and a region without `//golangci:enable` directive ends at the end of the file.
+func (p *Nolint) extractFileRegions(fset *token.FileSet, f *ast.File) []ignoredRange {
+	var regions, inProgress []ignoredRange
+
+	for _, g := range f.Comments {
+		for _, c := range g.List {
+			directive, ok := nolintlint.ParseRegionDirective(c.Text)
+			if !ok {
+				continue
+			}
+
+			pos := fset.Position(c.Pos())
+
+			if !directive.Enable && p.isExpired(c.Text) {
+				nolintDebugf("%d: directive %q is expired", pos.Line, c.Text)
+				continue
+			}
+
+			if directive.Enable {
+				for _, region := range inProgress {
+					region.To = pos.Line
+					regions = append(regions, region)
+				}
+
+				inProgress = nil
+
+				continue
+			}
+
+			region := ignoredRange{
+				Range:                  result.Range{From: pos.Line},
+				region:                 true,
+				matchedIssueFromLinter: make(map[string]bool),
+				matchedIssueFromRule:   make(map[string]bool),
+				position:               directivePosition(pos),
+				explanation:            directiveExplanation(c.Text),
+			}
+
+			if strings.TrimSpace(directive.Linters) != "" {
+				linters, rules, all := p.parseLinterItems(directive.Linters, pos.Line)
+				if !all {
+					region.linters = linters
+					region.rules = rules
+				}
+			}
+
+			inProgress = append(inProgress, region)
+		}
+	}
+
+	for _, region := range inProgress {
+		region.To = fset.File(f.Pos()).LineCount()
+		regions = append(regions, region)
+	}
+
+	nolintDebugf("regions are %+v", regions)
+
+	return regions
+}
+
+// isExpired reports whether the directive has an expiration date (ex: `//nolint:errcheck // JIRA-123 until=2026-12-01`)
+// in the past: the expired directives are not applied anymore.
+func (p *Nolint) isExpired(directive string) bool {
+	date, ok := nolintlint.ParseExpiration(directive)
+
+	return ok && !p.now.Before(date)
+}
+
+// parseLinterItems parses a comma-separated list of linters (ex: `gosec(G304),staticcheck`).
+// It returns true if the list contains `all`.
+func (p *Nolint) parseLinterItems(text string, line int) (linters []string, rules map[string][]string, all bool) {
+	rules = map[string][]string{}
+	unrestricted := map[string]bool{}
+
+	for _, item := range strings.Split(text, ",") {
+		linterName, rule := parseNolintItem(item)
 		if linterName == "all" {
 			p.unknownLintersSet = map[string]bool{}
-			return buildRange(nil)
+			return nil, nil, true
 		}
 
+		var names []string
+
 		lcs := p.dbManager.GetLinterConfigs(linterName)
 		if lcs == nil {
 			p.unknownLintersSet[linterName] = true
-			linters = append(linters, linterName)
-			nolintDebugf("unknown linter %s on line %d", linterName, fset.Position(g.Pos()).Line)
-			continue
+			names = append(names, linterName)
+			nolintDebugf("unknown linter %s on line %d", linterName, line)
 		}
 
 		for _, lc := range lcs {
-			linters = append(linters, lc.Name()) // normalize name to work with aliases
+			names = append(names, lc.Name()) // normalize name to work with aliases
 		}
+
+		for _, name := range names {
+			linters = append(linters, name)
+
+			if rule == "" {
+				unrestricted[name] = true
+			} else {
+				rules[name] = append(rules[name], rule)
+			}
+		}
+	}
+
+	// a linter mentioned without rules ignores all the rules of this linter
+	for name := range unrestricted {
+		delete(rules, name)
 	}
 
-	nolintDebugf("%d: linters are %s", fset.Position(g.Pos()).Line, linters)
-	return buildRange(linters)
+	return linters, rules, false
+}
+
+// parseNolintItem parses an element of a nolint directive: `linter` or `linter(rule)`.
+func parseNolintItem(item string) (linterName, rule string) {
+	linterName, rule, _ = strings.Cut(strings.TrimSpace(item), "(")
+
+	return strings.ToLower(strings.TrimSpace(linterName)), strings.TrimSpace(strings.TrimSuffix(rule, ")"))
+}
+
+func directivePosition(pos token.Position) string {
+	return fmt.Sprintf("%s:%d", pos.Filename, pos.Line)
+}
+
+// directiveExplanation returns the comment after a directive (ex: `//nolint:errcheck // explanation`).
+func directiveExplanation(text string) string {
+	_, explanation, _ := strings.Cut(strings.TrimLeft(text, "/ "), "//")
+
+	return strings.TrimSpace(explanation)
 }
 
 type rangeExpander struct {
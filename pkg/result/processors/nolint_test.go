@@ -361,3 +361,195 @@ func TestNolintUnused(t *testing.T) {
 		processAssertEmpty(t, p, nolintlintIssueVarcheck)
 	})
 }
+
+func TestNolintRules(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_rules.go")
+
+	newIssue := func(line int, fromLinter, ruleID string) result.Issue {
+		return result.Issue{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     line,
+			},
+			FromLinter: fromLinter,
+			RuleID:     ruleID,
+		}
+	}
+
+	enabledLinters := []string{"gosec", "nolintlint", "staticcheck"}
+
+	enabledSetLog := logutils.NewMockLog()
+	enabledSetLog.On("Infof", "Active %d linters: %s", len(enabledLinters), enabledLinters)
+
+	cfg := &config.Config{Linters: config.Linters{DisableAll: true, Enable: enabledLinters}}
+
+	dbManager, err := lintersdb.NewManager(enabledSetLog, cfg, lintersdb.NewLinterBuilder())
+	require.NoError(t, err)
+
+	enabledLintersMap, err := dbManager.GetEnabledLintersMap()
+	require.NoError(t, err)
+
+	p := NewNolint(getMockLog(), dbManager, enabledLintersMap)
+	defer p.Finish()
+
+	processAssertEmpty(t, p, newIssue(6, "gosec", "G304"))
+	processAssertEmpty(t, p, newIssue(6, "gosec", "g304"))
+	processAssertSame(t, p, newIssue(6, "gosec", "G401"))
+	processAssertSame(t, p, newIssue(6, "gosec", ""))
+	processAssertSame(t, p, newIssue(6, "staticcheck", "SA1000"))
+
+	// a linter mentioned without rule excludes all the rules of the linter
+	processAssertEmpty(t, p, newIssue(10, "gosec", "G304"))
+	processAssertEmpty(t, p, newIssue(10, "gosec", "G401"))
+
+	nolintlintIssue := func(line int, linter, rule string) result.Issue {
+		issue := newIssue(line, nolintlint.LinterName, "")
+		issue.ExpectNoLint = true
+		issue.ExpectedNoLintLinter = linter
+		issue.ExpectedNoLintRule = rule
+
+		return issue
+	}
+
+	processAssertEmpty(t, p, nolintlintIssue(6, "gosec", "G304"))
+	processAssertSame(t, p, nolintlintIssue(6, "staticcheck", "SA1019"))
+	processAssertEmpty(t, p, nolintlintIssue(10, "gosec", ""))
+}
+
+func TestNolintRegions(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_regions.go")
+
+	newIssue := func(line int, fromLinter string) result.Issue {
+		return result.Issue{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     line,
+			},
+			FromLinter: fromLinter,
+		}
+	}
+
+	enabledLinters := []string{"errcheck", "lll", "misspell", "nolintlint"}
+
+	enabledSetLog := logutils.NewMockLog()
+	enabledSetLog.On("Infof", "Active %d linters: %s", len(enabledLinters), enabledLinters)
+
+	cfg := &config.Config{Linters: config.Linters{DisableAll: true, Enable: enabledLinters}}
+
+	dbManager, err := lintersdb.NewManager(enabledSetLog, cfg, lintersdb.NewLinterBuilder())
+	require.NoError(t, err)
+
+	enabledLintersMap, err := dbManager.GetEnabledLintersMap()
+	require.NoError(t, err)
+
+	p := NewNolint(getMockLog(), dbManager, enabledLintersMap)
+	defer p.Finish()
+
+	processAssertEmpty(t, p, newIssue(5, "lll"))
+	processAssertEmpty(t, p, newIssue(6, "misspell"))
+	processAssertSame(t, p, newIssue(6, "errcheck"))
+	processAssertEmpty(t, p, newIssue(10, "errcheck"))
+	processAssertSame(t, p, newIssue(14, "lll"))
+
+	// unterminated region: until the end of the file
+	processAssertEmpty(t, p, newIssue(17, "errcheck"))
+
+	nolintlintIssue := func(line int, linter string) result.Issue {
+		issue := newIssue(line, nolintlint.LinterName)
+		issue.ExpectNoLint = true
+		issue.ExpectedNoLintLinter = linter
+
+		return issue
+	}
+
+	processAssertEmpty(t, p, nolintlintIssue(3, "lll"))
+	processAssertEmpty(t, p, nolintlintIssue(9, "errcheck"))
+	processAssertSame(t, p, nolintlintIssue(3, "errcheck"))
+	processAssertEmpty(t, p, nolintlintIssue(16, ""))
+}
+
+func TestNolintExpiration(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_expiration.go")
+
+	p := newTestNolintProcessor(getMockLog())
+	defer p.Finish()
+
+	newIssue := func(line int) result.Issue {
+		return result.Issue{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     line,
+			},
+			FromLinter: "varcheck",
+		}
+	}
+
+	processAssertEmpty(t, p, newIssue(3))
+	processAssertSame(t, p, newIssue(5))
+	processAssertEmpty(t, p, newIssue(7))
+	processAssertSame(t, p, newIssue(10))
+}
+
+func TestNolintGoMod(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_gomod", "go.mod")
+
+	p := newTestNolintProcessor(getMockLog())
+	defer p.Finish()
+
+	newIssue := func(line int, fromLinter string) result.Issue {
+		return result.Issue{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     line,
+			},
+			FromLinter: fromLinter,
+		}
+	}
+
+	processAssertSame(t, p, newIssue(5, "gomoddirectives"))
+
+	// inline comment
+	processAssertEmpty(t, p, newIssue(7, "gomoddirectives"))
+	processAssertSame(t, p, newIssue(7, "gomodguard"))
+
+	processAssertSame(t, p, newIssue(9, "gomoddirectives"))
+
+	// preceding comment
+	processAssertEmpty(t, p, newIssue(12, "gomoddirectives"))
+
+	// preceding comment for a block
+	for i := 15; i <= 18; i++ {
+		processAssertEmpty(t, p, newIssue(i, "gomodguard"))
+	}
+
+	// inline comment inside a block
+	processAssertEmpty(t, p, newIssue(21, "gomoddirectives"))
+	processAssertSame(t, p, newIssue(22, "gomoddirectives"))
+}
+
+func TestNolintSuppressions(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_expiration.go")
+
+	p := newTestNolintProcessor(getMockLog())
+	defer p.Finish()
+
+	issue := result.Issue{
+		Pos: token.Position{
+			Filename: fileName,
+			Line:     3,
+		},
+		FromLinter: "varcheck",
+	}
+
+	processAssertEmpty(t, p, issue, issue)
+
+	expected := []result.Suppression{{
+		Kind:   result.SuppressionNolint,
+		Name:   fileName + ":3",
+		Reason: "JIRA-123 until=2999-12-01",
+		Linter: "varcheck",
+		Count:  2,
+	}}
+
+	assert.Equal(t, expected, p.Suppressions())
+}
@@ -4,7 +4,10 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-const typeCheckName = "typecheck"
+const (
+	typeCheckName = "typecheck"
+	goModFileName = "go.mod"
+)
 
 type Processor interface {
 	Process(issues []result.Issue) ([]result.Issue, error)
@@ -2,10 +2,13 @@ package processors
 
 import (
 	"cmp"
+	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -17,6 +20,10 @@ var _ Processor = (*Severity)(nil)
 type severityRule struct {
 	baseRule
 	severity string
+
+	// Only used for logging.
+	name    string
+	matches int
 }
 
 type Severity struct {
@@ -24,16 +31,18 @@ type Severity struct {
 
 	log logutils.Log
 
-	files *fsutils.Files
+	files   *fsutils.Files
+	symbols *SymbolIndex
 
 	defaultSeverity string
 	rules           []severityRule
 }
 
-func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity) *Severity {
+func NewSeverity(log logutils.Log, files *fsutils.Files, symbols *SymbolIndex, cfg *config.Severity) *Severity {
 	p := &Severity{
 		name:            "severity-rules",
 		files:           files,
+		symbols:         symbols,
 		log:             log,
 		defaultSeverity: cfg.Default,
 	}
@@ -44,7 +53,7 @@ func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity) *
 		p.name = "severity-rules-case-sensitive"
 	}
 
-	p.rules = createSeverityRules(cfg.Rules, prefix)
+	p.rules = createSeverityRules(log, cfg.Rules, prefix)
 
 	return p
 }
@@ -61,9 +70,29 @@ func (p *Severity) Process(issues []result.Issue) ([]result.Issue, error) {
 
 func (*Severity) Finish() {}
 
+// UnusedRules returns the names of the rules that didn't match any issue.
+// The rules about disabled linters are ignored.
+func (p *Severity) UnusedRules(enabledLinters map[string]*linter.Config) []string {
+	var names []string
+
+	for i := range p.rules {
+		rule := &p.rules[i]
+
+		if rule.matches == 0 && rule.lintersEnabled(enabledLinters) {
+			names = append(names, rule.name)
+		}
+	}
+
+	return names
+}
+
 func (p *Severity) transform(issue *result.Issue) *result.Issue {
-	for _, rule := range p.rules {
-		if rule.match(issue, p.files, p.log) {
+	for i := range p.rules {
+		rule := &p.rules[i]
+
+		if rule.match(issue, p.files, p.symbols, p.log) {
+			rule.matches++
+
 			if rule.severity == severityFromLinter || (rule.severity == "" && p.defaultSeverity == severityFromLinter) {
 				return issue
 			}
@@ -81,11 +110,21 @@ func (p *Severity) transform(issue *result.Issue) *result.Issue {
 	return issue
 }
 
-func createSeverityRules(rules []config.SeverityRule, prefix string) []severityRule {
+func createSeverityRules(log logutils.Log, rules []config.SeverityRule, prefix string) []severityRule {
 	parsedRules := make([]severityRule, 0, len(rules))
 
-	for _, rule := range rules {
-		parsedRule := severityRule{}
+	now := time.Now()
+
+	for i := range rules {
+		rule := &rules[i]
+
+		name := fmt.Sprintf("severity.rules[%d]", i)
+
+		if isRuleExpired(log, name, &rule.BaseRule, now) {
+			continue
+		}
+
+		parsedRule := severityRule{name: name}
 		parsedRule.linters = rule.Linters
 		parsedRule.severity = rule.Severity
 
@@ -107,6 +146,14 @@ func createSeverityRules(rules []config.SeverityRule, prefix string) []severityR
 			parsedRule.pathExcept = regexp.MustCompile(pathExcept)
 		}
 
+		if rule.Symbol != "" {
+			parsedRule.symbol = regexp.MustCompile(rule.Symbol)
+		}
+
+		if rule.Package != "" {
+			parsedRule.pkg = regexp.MustCompile(rule.Package)
+		}
+
 		parsedRules = append(parsedRules, parsedRule)
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -79,7 +80,7 @@ func TestSeverity_multiple(t *testing.T) {
 		},
 	}
 
-	p := NewSeverity(log, files, opts)
+	p := NewSeverity(log, files, nil, opts)
 
 	cases := []issueTestCase{
 		{Path: "ssl.go", Text: "ssl", Linter: "gosec"},
@@ -147,7 +148,7 @@ func TestSeverity_pathPrefix(t *testing.T) {
 		},
 	}
 
-	p := NewSeverity(log, files, opts)
+	p := NewSeverity(log, files, nil, opts)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "some", Linter: "linter"},
@@ -192,7 +193,7 @@ func TestSeverity_text(t *testing.T) {
 		},
 	}
 
-	p := NewSeverity(nil, nil, opts)
+	p := NewSeverity(nil, nil, nil, opts)
 
 	texts := []string{"seveRity", "1", "", "serverit", "notseverity"}
 	var issues []result.Issue
@@ -224,7 +225,7 @@ func TestSeverity_onlyDefault(t *testing.T) {
 		Rules:   []config.SeverityRule{},
 	}
 
-	p := NewSeverity(log, files, &opts)
+	p := NewSeverity(log, files, nil, &opts)
 
 	cases := []issueTestCase{
 		{Path: "ssl.go", Text: "ssl", Linter: "gosec"},
@@ -257,8 +258,71 @@ func TestSeverity_onlyDefault(t *testing.T) {
 	assert.Equal(t, expectedCases, resultingCases)
 }
 
+func TestSeverity_expired(t *testing.T) {
+	log := logutils.NewMockLog().
+		OnWarnf("The rule %s expired on %s, it's no longer applied.", "severity.rules[0]", "2000-01-01")
+
+	opts := &config.Severity{
+		Default: "error",
+		Rules: []config.SeverityRule{
+			{
+				Severity: "info",
+				BaseRule: config.BaseRule{
+					Linters: []string{"linter"},
+					Expires: "2000-01-01",
+				},
+			},
+		},
+	}
+
+	p := NewSeverity(log, nil, nil, opts)
+
+	processedIssues := process(t, p, result.Issue{Text: "text", FromLinter: "linter"})
+
+	assert.Equal(t, []result.Issue{{Text: "text", FromLinter: "linter", Severity: "error"}}, processedIssues)
+
+	log.AssertExpectations(t)
+}
+
+func TestSeverity_UnusedRules(t *testing.T) {
+	opts := &config.Severity{
+		Default: "error",
+		Rules: []config.SeverityRule{
+			{
+				Severity: "info",
+				BaseRule: config.BaseRule{
+					Linters: []string{"linter"},
+				},
+			},
+			{
+				Severity: "info",
+				BaseRule: config.BaseRule{
+					Linters: []string{"other"},
+				},
+			},
+			{
+				Severity: "info",
+				BaseRule: config.BaseRule{
+					Linters: []string{"disabled"},
+				},
+			},
+		},
+	}
+
+	p := NewSeverity(nil, nil, nil, opts)
+
+	process(t, p, result.Issue{Text: "text", FromLinter: "linter"})
+
+	enabledLinters := map[string]*linter.Config{
+		"linter": {},
+		"other":  {},
+	}
+
+	assert.Equal(t, []string{"severity.rules[1]"}, p.UnusedRules(enabledLinters))
+}
+
 func TestSeverity_empty(t *testing.T) {
-	p := NewSeverity(nil, nil, &config.Severity{})
+	p := NewSeverity(nil, nil, nil, &config.Severity{})
 
 	processAssertSame(t, p, newIssueFromTextTestCase("test"))
 }
@@ -281,7 +345,7 @@ func TestSeverity_caseSensitive(t *testing.T) {
 		CaseSensitive: true,
 	}
 
-	p := NewSeverity(nil, files, opts)
+	p := NewSeverity(nil, files, nil, opts)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "ssL", Linter: "gosec"},
@@ -486,7 +550,7 @@ func TestSeverity_transform(t *testing.T) {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			p := NewSeverity(nil, files, test.opts)
+			p := NewSeverity(nil, files, nil, test.opts)
 
 			newIssue := p.transform(test.issue)
 
@@ -33,6 +33,8 @@ type SkipDirs struct {
 	absArgsDirs      []string
 	skippedDirsCache map[string]bool
 	pathPrefix       string
+
+	suppressions suppressionCounter
 }
 
 func NewSkipDirs(log logutils.Log, patterns, args []string, pathPrefix string) (*SkipDirs, error) {
@@ -58,6 +60,7 @@ func NewSkipDirs(log logutils.Log, patterns, args []string, pathPrefix string) (
 		absArgsDirs:      absArgsDirs,
 		skippedDirsCache: map[string]bool{},
 		pathPrefix:       pathPrefix,
+		suppressions:     suppressionCounter{},
 	}, nil
 }
 
@@ -79,6 +82,11 @@ func (p *SkipDirs) Finish() {
 	}
 }
 
+// Suppressions returns the issues skipped because of the patterns of directories.
+func (p *SkipDirs) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
 func (p *SkipDirs) shouldPassIssue(issue *result.Issue) bool {
 	if filepath.IsAbs(issue.FilePath()) {
 		if isGoFile(issue.FilePath()) {
@@ -92,6 +100,7 @@ func (p *SkipDirs) shouldPassIssue(issue *result.Issue) bool {
 	if toPass, ok := p.skippedDirsCache[issueRelDir]; ok {
 		if !toPass {
 			p.skippedDirs[issueRelDir].count++
+			p.suppressions.add(result.SuppressionExcludeDirs, p.skippedDirs[issueRelDir].pattern, "", issue)
 		}
 		return toPass
 	}
@@ -104,6 +113,11 @@ func (p *SkipDirs) shouldPassIssue(issue *result.Issue) bool {
 
 	toPass := p.shouldPassIssueDirs(issueRelDir, issueAbsDir)
 	p.skippedDirsCache[issueRelDir] = toPass
+
+	if !toPass {
+		p.suppressions.add(result.SuppressionExcludeDirs, p.skippedDirs[issueRelDir].pattern, "", issue)
+	}
+
 	return toPass
 }
 
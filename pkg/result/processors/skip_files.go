@@ -13,6 +13,8 @@ var _ Processor = (*SkipFiles)(nil)
 type SkipFiles struct {
 	patterns   []*regexp.Regexp
 	pathPrefix string
+
+	suppressions suppressionCounter
 }
 
 func NewSkipFiles(patterns []string, pathPrefix string) (*SkipFiles, error) {
@@ -29,16 +31,17 @@ func NewSkipFiles(patterns []string, pathPrefix string) (*SkipFiles, error) {
 	}
 
 	return &SkipFiles{
-		patterns:   patternsRe,
-		pathPrefix: pathPrefix,
+		patterns:     patternsRe,
+		pathPrefix:   pathPrefix,
+		suppressions: suppressionCounter{},
 	}, nil
 }
 
-func (SkipFiles) Name() string {
+func (*SkipFiles) Name() string {
 	return "skip_files"
 }
 
-func (p SkipFiles) Process(issues []result.Issue) ([]result.Issue, error) {
+func (p *SkipFiles) Process(issues []result.Issue) ([]result.Issue, error) {
 	if len(p.patterns) == 0 {
 		return issues, nil
 	}
@@ -48,6 +51,7 @@ func (p SkipFiles) Process(issues []result.Issue) ([]result.Issue, error) {
 
 		for _, pattern := range p.patterns {
 			if pattern.MatchString(path) {
+				p.suppressions.add(result.SuppressionExcludeFiles, pattern.String(), "", issue)
 				return false
 			}
 		}
@@ -56,4 +60,9 @@ func (p SkipFiles) Process(issues []result.Issue) ([]result.Issue, error) {
 	}), nil
 }
 
-func (SkipFiles) Finish() {}
+func (*SkipFiles) Finish() {}
+
+// Suppressions returns the issues skipped because of the patterns of files.
+func (p *SkipFiles) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
@@ -52,3 +52,20 @@ func TestSkipFilesInvalidPattern(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, p)
 }
+
+func TestSkipFiles_hiddenBy(t *testing.T) {
+	p := newTestSkipFiles(t, `\.pb\.go$`)
+
+	issues := []result.Issue{newFileIssue("a/b.pb.go"), newFileIssue("a/b.go")}
+
+	processedIssues := process(t, p, issues...)
+	assert.Equal(t, issues[1:], processedIssues)
+
+	expected := &result.Suppression{
+		Kind: result.SuppressionExcludeFiles,
+		Name: `\.pb\.go$`,
+	}
+
+	assert.Equal(t, expected, issues[0].HiddenBy)
+	assert.Nil(t, issues[1].HiddenBy)
+}
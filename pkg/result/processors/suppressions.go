@@ -0,0 +1,57 @@
+package processors
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// suppressionCounter counts the issues hidden by a processor, per mechanism, linter and package.
+type suppressionCounter map[result.Suppression]int
+
+// add counts the issue, and marks it as hidden.
+func (c suppressionCounter) add(kind, name, reason string, issue *result.Issue) {
+	s := result.Suppression{
+		Kind:    kind,
+		Name:    name,
+		Reason:  reason,
+		Linter:  issue.FromLinter,
+		Package: issuePackage(issue),
+	}
+
+	c[s]++
+
+	issue.HiddenBy = &s
+}
+
+// list returns the suppressions sorted by kind, name, linter and package.
+func (c suppressionCounter) list() []result.Suppression {
+	suppressions := make([]result.Suppression, 0, len(c))
+
+	for s, count := range c {
+		s.Count = count
+		suppressions = append(suppressions, s)
+	}
+
+	slices.SortFunc(suppressions, func(a, b result.Suppression) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Linter, b.Linter),
+			cmp.Compare(a.Package, b.Package),
+		)
+	})
+
+	return suppressions
+}
+
+// issuePackage returns the path of the package of the issue,
+// or an empty string when the package is unknown (ex: issues restored from the cache), like the rules about packages.
+func issuePackage(issue *result.Issue) string {
+	if issue.Pkg == nil {
+		return ""
+	}
+
+	return issue.Pkg.PkgPath
+}
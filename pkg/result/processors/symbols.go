@@ -0,0 +1,131 @@
+package processors
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// symbolRange is the range of lines of a top-level declaration.
+type symbolRange struct {
+	result.Range
+	name string
+}
+
+// SymbolIndex provides the symbol enclosing an issue: the name of the function, the method (`Type.Method`), or the type.
+// The declarations of a file are parsed once, the first time an issue of this file is looked up:
+// the index is shared by the processors based on rules.
+type SymbolIndex struct {
+	log   logutils.Log
+	files map[string][]symbolRange
+}
+
+func NewSymbolIndex(log logutils.Log) *SymbolIndex {
+	return &SymbolIndex{
+		log:   log,
+		files: map[string][]symbolRange{},
+	}
+}
+
+// lookup returns the symbol enclosing the issue, or an empty string if the issue is outside a function, a method, or a type.
+func (s *SymbolIndex) lookup(issue *result.Issue) string {
+	ranges, ok := s.files[issue.FilePath()]
+	if !ok {
+		ranges = s.parse(issue.FilePath())
+		s.files[issue.FilePath()] = ranges
+	}
+
+	for _, r := range ranges {
+		if r.From <= issue.Line() && issue.Line() <= r.To {
+			return r.name
+		}
+	}
+
+	return ""
+}
+
+func (s *SymbolIndex) parse(filePath string) []symbolRange {
+	if !isGoFile(filePath) {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		if s.log != nil {
+			s.log.Warnf("Failed to parse %s to find the symbols: %v", filePath, err)
+		}
+
+		return nil
+	}
+
+	newRange := func(name string, node ast.Node, doc *ast.CommentGroup) symbolRange {
+		from := node.Pos()
+		if doc != nil {
+			from = doc.Pos()
+		}
+
+		return symbolRange{
+			Range: result.Range{From: fset.Position(from).Line, To: fset.Position(node.End()).Line},
+			name:  name,
+		}
+	}
+
+	var ranges []symbolRange
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			name := d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				name = receiverTypeName(d.Recv.List[0].Type) + "." + name
+			}
+
+			ranges = append(ranges, newRange(name, d, d.Doc))
+
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				// Without parentheses, the documentation is attached to the declaration.
+				if !d.Lparen.IsValid() {
+					ranges = append(ranges, newRange(ts.Name.Name, d, d.Doc))
+					continue
+				}
+
+				ranges = append(ranges, newRange(ts.Name.Name, ts, ts.Doc))
+			}
+		}
+	}
+
+	return ranges
+}
+
+// receiverTypeName returns the name of the type of a receiver (ex: `T` for `*T`, `T[K, V]`).
+func receiverTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(e.X)
+	case *ast.ParenExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(e.X)
+	case *ast.Ident:
+		return e.Name
+	default:
+		return ""
+	}
+}
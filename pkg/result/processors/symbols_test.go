@@ -0,0 +1,39 @@
+package processors
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func Test_symbolIndex_lookup(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		line     int
+		expected string
+	}{
+		{desc: "package clause", line: 1, expected: ""},
+		{desc: "global variable", line: 5, expected: ""},
+		{desc: "function documentation", line: 7, expected: "TestMain"},
+		{desc: "function body", line: 9, expected: "TestMain"},
+		{desc: "generic type", line: 13, expected: "Foo"},
+		{desc: "method of generic type", line: 17, expected: "Foo.Bar"},
+		{desc: "type in a group", line: 21, expected: "A"},
+		{desc: "documentation of a type in a group", line: 23, expected: "B"},
+		{desc: "between the types of a group", line: 22, expected: ""},
+	}
+
+	idx := NewSymbolIndex(getMockLog())
+
+	for _, test := range testCases {
+		issue := &result.Issue{
+			Pos: token.Position{Filename: filepath.Join("testdata", "exclude_rules_symbols.go"), Line: test.line},
+		}
+
+		assert.Equal(t, test.expected, idx.lookup(issue), test.desc)
+	}
+}
@@ -0,0 +1,7 @@
+// This file is produced by internal-gen, the changes will be lost.
+
+package testdata
+
+func _() {
+
+}
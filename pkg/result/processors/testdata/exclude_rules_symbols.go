@@ -0,0 +1,25 @@
+package testdata
+
+import "testing"
+
+var global = 1
+
+// TestMain is excluded.
+func TestMain(m *testing.M) {
+	m.Run()
+}
+
+type Foo[T any] struct {
+	value T
+}
+
+func (f *Foo[T]) Bar() T {
+	return f.value
+}
+
+type (
+	A int
+
+	// B is documented.
+	B int
+)
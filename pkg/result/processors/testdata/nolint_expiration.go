@@ -0,0 +1,12 @@
+package testdata
+
+var nolintNotExpired int //nolint:varcheck // JIRA-123 until=2999-12-01
+
+var nolintExpired int //nolint:varcheck // JIRA-123 until=2020-12-01
+
+var nolintInvalidExpiration int //nolint:varcheck // JIRA-123 until=tomorrow
+
+//golangci:disable varcheck // until=2020-12-01
+var regionExpired int
+
+//golangci:enable
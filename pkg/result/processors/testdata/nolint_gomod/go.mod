@@ -0,0 +1,23 @@
+module example.com/nolint
+
+go 1.22
+
+require github.com/golangci/example v1.0.0
+
+replace github.com/golangci/example => ../example // nolint:gomoddirectives // local fork
+
+retract v1.0.1
+
+//nolint:gomoddirectives
+retract v1.0.2
+
+//nolint:gomodguard
+replace (
+	github.com/golangci/a => ../a
+	github.com/golangci/b => ../b
+)
+
+replace (
+	github.com/golangci/c => ../c //nolint:gomoddirectives
+	github.com/golangci/d => ../d
+)
@@ -0,0 +1,17 @@
+package testdata
+
+//golangci:disable lll,misspell // generated table
+var table = []string{
+	"a very long line",
+	"a mispelled line",
+}
+
+//nolint:errcheck
+var other int
+
+//golangci:enable
+
+var notInRegion int
+
+//golangci:disable
+var all int
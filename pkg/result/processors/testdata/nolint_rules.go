@@ -0,0 +1,11 @@
+package testdata
+
+import "os"
+
+func readFile(path string) ([]byte, error) {
+	return os.ReadFile(path) //nolint:gosec(G304),staticcheck(SA1019)
+}
+
+func readAnyFile(path string) ([]byte, error) {
+	return os.ReadFile(path) //nolint:gosec(G304),gosec
+}
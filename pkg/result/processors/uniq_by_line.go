@@ -1,6 +1,8 @@
 package processors
 
 import (
+	"fmt"
+
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -12,12 +14,15 @@ var _ Processor = (*UniqByLine)(nil)
 type UniqByLine struct {
 	fileLineCounter fileLineCounter
 	cfg             *config.Config
+
+	suppressions suppressionCounter
 }
 
 func NewUniqByLine(cfg *config.Config) *UniqByLine {
 	return &UniqByLine{
 		fileLineCounter: fileLineCounter{},
 		cfg:             cfg,
+		suppressions:    suppressionCounter{},
 	}
 }
 
@@ -35,6 +40,11 @@ func (p *UniqByLine) Process(issues []result.Issue) ([]result.Issue, error) {
 
 func (*UniqByLine) Finish() {}
 
+// Suppressions returns the issues hidden because another issue was reported on the same line.
+func (p *UniqByLine) Suppressions() []result.Suppression {
+	return p.suppressions.list()
+}
+
 func (p *UniqByLine) shouldPassIssue(issue *result.Issue) bool {
 	if issue.Replacement != nil && p.cfg.Issues.NeedFix {
 		// if issue will be auto-fixed we shouldn't collapse issues:
@@ -43,6 +53,7 @@ func (p *UniqByLine) shouldPassIssue(issue *result.Issue) bool {
 	}
 
 	if p.fileLineCounter.GetCount(issue) == uniqByLineLimit {
+		p.suppressions.add(result.SuppressionUniqByLine, fmt.Sprintf("%s:%d", issue.FilePath(), issue.Line()), "", issue)
 		return false
 	}
 
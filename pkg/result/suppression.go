@@ -0,0 +1,44 @@
+package result
+
+// The kinds of suppressions.
+const (
+	SuppressionNolint               = "nolint"
+	SuppressionExcludeRule          = "exclude-rule"
+	SuppressionDefaultExclusion     = "default-exclusion"
+	SuppressionExclude              = "exclude"
+	SuppressionExcludeDirs          = "exclude-dirs"
+	SuppressionExcludeFiles         = "exclude-files"
+	SuppressionGenerated            = "generated"
+	SuppressionUniqByLine           = "uniq-by-line"
+	SuppressionEquivalent           = "equivalent"
+	SuppressionNewFromRev           = "new-from-rev"
+	SuppressionMaxSameIssues        = "max-same-issues"
+	SuppressionMaxIssuesPerLinter   = "max-issues-per-linter"
+	SuppressionMaxPerFileFromLinter = "max-per-file-from-linter"
+)
+
+// Suppression is a group of issues, from the same linter and package, hidden by the same mechanism
+// (nolint directive, exclude rule, generated file, limit of issues, etc.).
+type Suppression struct {
+	Kind string
+
+	// Name identifies the mechanism: the position of the nolint directive, the name of the rule,
+	// the generated file, the text of the issues, etc.
+	Name   string
+	Reason string `json:",omitempty"`
+
+	Linter  string
+	Package string `json:",omitempty"`
+
+	Count int
+}
+
+// HiddenIssue is an issue hidden by a processor, with the mechanism that hid it.
+type HiddenIssue struct {
+	Issue Issue
+
+	Processor string
+	Kind      string
+	Name      string
+	Reason    string `json:",omitempty"`
+}